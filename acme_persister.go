@@ -0,0 +1,238 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package clientnative
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/google/renameio"
+	"github.com/haproxytech/client-native/v6/models"
+	"github.com/haproxytech/client-native/v6/runtime"
+	"github.com/haproxytech/client-native/v6/storage"
+)
+
+// AcmeNewCertEvent is the prefix of the event sent by HAProxy when ACME renewed a certificate
+const AcmeNewCertEvent = "acme newcert "
+
+// ErrAcmeCertificateUnknown is returned when a renewed certificate matches no file to persist it to
+var ErrAcmeCertificateUnknown = errors.New("no file found for certificate")
+
+// AcmePersister writes certificates renewed by HAProxy's ACME client to disk,
+// so that they survive a restart of HAProxy.
+type AcmePersister struct {
+	client HAProxyClient
+	// OnError, when set, is called when a renewed certificate could not be persisted.
+	// Listening for events continues afterwards.
+	OnError func(certificate string, err error)
+	// OnPersist, when set, is called with the files written for a renewed certificate
+	OnPersist func(certificate string, files []string)
+	mu        sync.Mutex
+}
+
+// NewAcmePersister returns an ACME persister using the runtime, configuration
+// and SSL certificate storage of the client
+func NewAcmePersister(client HAProxyClient) *AcmePersister {
+	return &AcmePersister{client: client}
+}
+
+// Run persists the certificates announced by the acme newcert events of the listener,
+// until ctx is done or the listener fails. The listener must be created on the sink
+// receiving the ACME events, usually "dpapi", and is closed when Run returns.
+func (p *AcmePersister) Run(ctx context.Context, listener *runtime.EventListener) error {
+	defer listener.Close()
+	for {
+		event, err := listener.Listen(ctx)
+		if ctx.Err() != nil {
+			return nil //nolint:nilerr
+		}
+		if err != nil {
+			return err
+		}
+		certificate, ok := strings.CutPrefix(event.Message, AcmeNewCertEvent)
+		if !ok {
+			continue
+		}
+		certificate = strings.TrimSpace(certificate)
+		files, err := p.Persist(certificate)
+		if err != nil {
+			if p.OnError != nil {
+				p.OnError(certificate, err)
+			}
+			continue
+		}
+		if p.OnPersist != nil {
+			p.OnPersist(certificate, files)
+		}
+	}
+}
+
+// Persist dumps the certificate from the running HAProxy and writes it atomically
+// to the SSL certificate storage, or to the files of the crt-store load it comes from.
+// When the crt-store load has a separate key file, the private key is written there.
+// Returns the files written.
+func (p *AcmePersister) Persist(certificate string) ([]string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	rt, err := p.client.Runtime()
+	if err != nil {
+		return nil, err
+	}
+	payload, err := rt.DumpCertificate(certificate)
+	if err != nil {
+		return nil, fmt.Errorf("cannot dump certificate %s: %w", certificate, err)
+	}
+	if err = storage.ValidateCertificateBundle([]byte(payload), nil); err != nil {
+		return nil, fmt.Errorf("invalid certificate %s: %w", certificate, err)
+	}
+
+	var files []string
+	if path, ok := p.storageFile(certificate); ok {
+		certStorage, _ := p.client.SSLCertStorage()
+		if _, err = certStorage.Replace(filepath.Base(path), payload); err != nil {
+			return nil, err
+		}
+		files = append(files, path)
+	}
+
+	loads, err := p.crtStoreLoads(certificate)
+	if err != nil {
+		return files, err
+	}
+	for _, load := range loads {
+		written, err := writeCrtLoad(load, payload)
+		files = append(files, written...)
+		if err != nil {
+			return files, err
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%w %s", ErrAcmeCertificateUnknown, certificate)
+	}
+	return files, nil
+}
+
+// storageFile returns the path of the certificate in the SSL certificate storage
+func (p *AcmePersister) storageFile(certificate string) (string, bool) {
+	certStorage, err := p.client.SSLCertStorage()
+	if err != nil || certStorage == nil || strings.HasPrefix(certificate, "@") {
+		return "", false
+	}
+	path, _, err := certStorage.Get(filepath.Base(certificate))
+	if err != nil {
+		return "", false
+	}
+	if filepath.IsAbs(certificate) && filepath.Clean(certificate) != path {
+		return "", false
+	}
+	return path, true
+}
+
+// acmeCrtLoad is a crt-store load with its paths resolved
+type acmeCrtLoad struct {
+	certificate string
+	key         string
+}
+
+// crtStoreLoads returns the crt-store loads matching the certificate name used by HAProxy:
+// @<store>/<alias>, @<store>/<certificate> or the certificate path itself
+func (p *AcmePersister) crtStoreLoads(certificate string) ([]acmeCrtLoad, error) {
+	cfg, err := p.client.Configuration()
+	if err != nil {
+		return nil, nil //nolint:nilerr
+	}
+	_, stores, err := cfg.GetCrtStores("")
+	if err != nil {
+		return nil, err
+	}
+
+	var loads []acmeCrtLoad
+	for _, store := range stores {
+		if store == nil {
+			continue
+		}
+		for _, load := range store.CrtLoads {
+			if !crtLoadMatches(store, load, certificate) {
+				continue
+			}
+			l := acmeCrtLoad{certificate: joinBase(store.CrtBase, load.Certificate)}
+			if load.Key != "" {
+				l.key = joinBase(store.KeyBase, load.Key)
+			}
+			loads = append(loads, l)
+		}
+	}
+	return loads, nil
+}
+
+func crtLoadMatches(store *models.CrtStore, load models.CrtLoad, certificate string) bool {
+	if store.Name != "" {
+		if load.Alias != "" && certificate == "@"+store.Name+"/"+load.Alias {
+			return true
+		}
+		if certificate == "@"+store.Name+"/"+load.Certificate {
+			return true
+		}
+	}
+	return certificate == load.Certificate || certificate == joinBase(store.CrtBase, load.Certificate)
+}
+
+func joinBase(base, name string) string {
+	if base == "" || filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(base, name)
+}
+
+// writeCrtLoad writes the dumped payload to the files of a crt-store load.
+// The private key is split into its own file when the load has one.
+func writeCrtLoad(load acmeCrtLoad, payload string) ([]string, error) {
+	if load.key == "" {
+		if err := renameio.WriteFile(load.certificate, []byte(payload), 0o644); err != nil {
+			return nil, err
+		}
+		return []string{load.certificate}, nil
+	}
+
+	var crt, key []byte
+	rest := []byte(payload)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if strings.HasSuffix(block.Type, "PRIVATE KEY") {
+			key = append(key, pem.EncodeToMemory(block)...)
+		} else {
+			crt = append(crt, pem.EncodeToMemory(block)...)
+		}
+	}
+	if err := renameio.WriteFile(load.key, key, 0o600); err != nil {
+		return nil, err
+	}
+	if err := renameio.WriteFile(load.certificate, crt, 0o644); err != nil {
+		return []string{load.key}, err
+	}
+	return []string{load.certificate, load.key}, nil
+}
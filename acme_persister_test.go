@@ -0,0 +1,141 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package clientnative
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/haproxytech/client-native/v6/configuration"
+	"github.com/haproxytech/client-native/v6/models"
+	"github.com/haproxytech/client-native/v6/options"
+	"github.com/haproxytech/client-native/v6/runtime"
+	"github.com/haproxytech/client-native/v6/storage"
+	"github.com/stretchr/testify/require"
+)
+
+type acmeConfigurationMock struct {
+	configuration.Configuration
+
+	stores models.CrtStores
+}
+
+func (c *acmeConfigurationMock) GetCrtStores(_ string) (int64, models.CrtStores, error) {
+	return 1, c.stores, nil
+}
+
+func TestAcmePersister(t *testing.T) {
+	oldPEM, err := os.ReadFile("storage/test-certs/valid/selfsigned1.pem")
+	require.NoError(t, err)
+	newPEM, err := os.ReadFile("storage/test-certs/valid/OK-crt_key_int1_int2.pem")
+	require.NoError(t, err)
+
+	certDir := t.TempDir()
+	certPath := filepath.Join(certDir, "site.pem")
+	require.NoError(t, os.WriteFile(certPath, oldPEM, 0o644))
+	certStorage, err := storage.New(certDir, storage.SSLType)
+	require.NoError(t, err)
+
+	storeDir := t.TempDir()
+	cfg := &acmeConfigurationMock{
+		stores: models.CrtStores{
+			&models.CrtStore{
+				CrtStoreBase: models.CrtStoreBase{Name: "web", CrtBase: storeDir, KeyBase: storeDir},
+				CrtLoads: map[string]models.CrtLoad{
+					"api.pem": {Certificate: "api.pem", Key: "api.key", Alias: "api", Acme: "LE"},
+				},
+			},
+		},
+	}
+	rt := &certManagerRuntimeMock{
+		certs: map[string]string{
+			certPath:      string(newPEM),
+			"@web/api":    string(newPEM),
+			"missing.pem": string(newPEM),
+		},
+	}
+
+	client, err := New(context.Background(),
+		options.SSLCertStorage(certStorage),
+		options.Configuration(cfg),
+		options.Runtime(rt),
+	)
+	require.NoError(t, err)
+	p := NewAcmePersister(client)
+
+	files, err := p.Persist(certPath)
+	require.NoError(t, err)
+	require.Equal(t, []string{certPath}, files)
+	contents, err := os.ReadFile(certPath)
+	require.NoError(t, err)
+	require.Equal(t, newPEM, contents)
+
+	files, err = p.Persist("@web/api")
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(storeDir, "api.pem"), filepath.Join(storeDir, "api.key")}, files)
+	crt, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.NotContains(t, string(crt), "PRIVATE KEY")
+	key, err := os.ReadFile(files[1])
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(key), "-----BEGIN"))
+	require.Contains(t, string(key), "PRIVATE KEY")
+
+	_, err = p.Persist("missing.pem")
+	require.ErrorIs(t, err, ErrAcmeCertificateUnknown)
+}
+
+func TestAcmePersister_Run(t *testing.T) {
+	newPEM, err := os.ReadFile("storage/test-certs/valid/OK-crt_key_int1_int2.pem")
+	require.NoError(t, err)
+
+	certDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(certDir, "foobar.pem"), newPEM, 0o644))
+	certStorage, err := storage.New(certDir, storage.SSLType)
+	require.NoError(t, err)
+
+	client, err := New(context.Background(),
+		options.SSLCertStorage(certStorage),
+		options.Runtime(&certManagerRuntimeMock{certs: map[string]string{"foobar.pem": string(newPEM)}}),
+	)
+	require.NoError(t, err)
+
+	haProxy := runtime.NewHAProxyMock(t)
+	haProxy.Start()
+	defer haProxy.Stop()
+	haProxy.SetResponses(&map[string]string{
+		"show events dpapi -w -0\n": "<0>2025-05-19T15:56:23.059755+02:00 acme newcert foobar.pem\n\x00",
+	})
+	listener, err := runtime.NewEventListener("unix", haProxy.Addr().String(), "dpapi", time.Second, "-w", "-0")
+	require.NoError(t, err)
+
+	var persisted []string
+	p := NewAcmePersister(client)
+	p.OnPersist = func(certificate string, files []string) {
+		persisted = append(persisted, certificate)
+	}
+	p.OnError = func(certificate string, err error) {
+		t.Errorf("cannot persist %s: %v", certificate, err)
+	}
+
+	// the mock closes the connection after the event
+	_ = p.Run(t.Context(), listener)
+	require.Equal(t, []string{"foobar.pem"}, persisted)
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package capabilities
+
+// Names of the capabilities checked by client-native
+const (
+	CmdAcmeRenew       = "acme renew"
+	CmdAcmeStatus      = "acme status"
+	CmdAddServer       = "add server"
+	CmdDelServer       = "del server"
+	CmdDumpSSLCert     = "dump ssl cert"
+	CmdEcho            = "echo"
+	CmdPrepareACL      = "prepare acl"
+	CmdPrepareMap      = "prepare map"
+	CmdReload          = "reload"
+	CmdSetSSLTLSKey    = "set ssl tls-key"
+	CmdShowCache       = "show cache"
+	CmdShowQuic        = "show quic"
+	CmdShowServersConn = "show servers conn"
+	CmdShowStartupLogs = "show startup-logs"
+	CmdShowTLSKeys     = "show tls-keys"
+
+	KeywordAcme       = "acme"
+	KeywordCrtStore   = "crt-store"
+	KeywordLogProfile = "log-profile"
+	KeywordTraces     = "traces"
+
+	OptionHTTPRestrictReqHdrNames = "http-restrict-req-hdr-names"
+)
+
+var builtin = []Capability{ //nolint:gochecknoglobals
+	{Kind: Command, Name: CmdAcmeRenew, Since: "3.2"},
+	{Kind: Command, Name: CmdAcmeStatus, Since: "3.2"},
+	{Kind: Command, Name: CmdAddServer, Since: "2.6"},
+	{Kind: Command, Name: CmdDelServer, Since: "2.6"},
+	{Kind: Command, Name: CmdDumpSSLCert, Since: "3.2"},
+	{Kind: Command, Name: CmdEcho, Since: "2.4"},
+	{Kind: Command, Name: CmdPrepareACL, Since: "2.4"},
+	{Kind: Command, Name: CmdPrepareMap, Since: "2.4"},
+	{Kind: Command, Name: CmdReload, Since: "2.7"},
+	{Kind: Command, Name: CmdSetSSLTLSKey, Since: "1.9"},
+	{Kind: Command, Name: CmdShowCache, Since: "1.8"},
+	{Kind: Command, Name: CmdShowQuic, Since: "2.8"},
+	{Kind: Command, Name: CmdShowServersConn, Since: "2.3"},
+	{Kind: Command, Name: CmdShowStartupLogs, Since: "2.7"},
+	{Kind: Command, Name: CmdShowTLSKeys, Since: "1.9"},
+
+	{Kind: Keyword, Name: KeywordAcme, Since: "3.2"},
+	{Kind: Keyword, Name: KeywordCrtStore, Since: "3.0"},
+	{Kind: Keyword, Name: KeywordLogProfile, Since: "3.1"},
+	{Kind: Keyword, Name: KeywordTraces, Since: "3.1"},
+
+	{Kind: Option, Name: OptionHTTPRestrictReqHdrNames, Since: "2.6"},
+}
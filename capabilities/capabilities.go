@@ -0,0 +1,141 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package capabilities records which runtime API commands, configuration keywords
+// and options are supported by each HAProxy version.
+package capabilities
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// ErrUnsupported is returned when a capability is not available in the HAProxy version
+var ErrUnsupported = errors.New("not supported")
+
+// Kind is the kind of a capability
+type Kind string
+
+const (
+	// Command is a runtime API or master CLI command
+	Command Kind = "command"
+	// Keyword is a configuration section or directive
+	Keyword Kind = "keyword"
+	// Option is a configuration `option` or a directive argument
+	Option Kind = "option"
+)
+
+// Capability is a feature available from the Since version, and up to but
+// excluding the Until version when it has been removed
+type Capability struct {
+	Kind  Kind
+	Name  string
+	Since string
+	Until string
+}
+
+// Registry holds the known capabilities. It is safe for concurrent use.
+type Registry struct {
+	capabilities map[Kind]map[string]Capability
+	mu           sync.RWMutex
+}
+
+// NewRegistry returns a registry with the given capabilities
+func NewRegistry(capabilities ...Capability) *Registry {
+	r := &Registry{capabilities: map[Kind]map[string]Capability{}}
+	for _, c := range capabilities {
+		r.Register(c)
+	}
+	return r
+}
+
+var defaultRegistry = NewRegistry(builtin...) //nolint:gochecknoglobals
+
+// Default returns the registry of the capabilities known by client-native.
+// Capabilities registered on it are visible to all clients.
+func Default() *Registry {
+	return defaultRegistry
+}
+
+// Register adds or replaces a capability
+func (r *Registry) Register(c Capability) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.capabilities[c.Kind] == nil {
+		r.capabilities[c.Kind] = map[string]Capability{}
+	}
+	r.capabilities[c.Kind][c.Name] = c
+}
+
+// Get returns a capability by kind and name
+func (r *Registry) Get(kind Kind, name string) (Capability, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.capabilities[kind][name]
+	return c, ok
+}
+
+// Check returns nil when the capability is available in version. Unknown capabilities
+// are considered available, and so is everything when version is nil, as the
+// version of HAProxy may not be known.
+func (r *Registry) Check(version *semver.Version, kind Kind, name string) error {
+	c, ok := r.Get(kind, name)
+	if !ok || version == nil {
+		return nil
+	}
+	// development versions such as 3.2-dev6 already ship the features of their release
+	current := semver.New(version.Major(), version.Minor(), version.Patch(), "", "")
+	if c.Since != "" {
+		since, err := semver.NewVersion(c.Since)
+		if err == nil && current.LessThan(since) {
+			return fmt.Errorf("%s '%s' requires HAProxy %s or later but current version is %s: %w", kind, name, c.Since, version.String(), ErrUnsupported)
+		}
+	}
+	if c.Until != "" {
+		until, err := semver.NewVersion(c.Until)
+		if err == nil && !current.LessThan(until) {
+			return fmt.Errorf("%s '%s' was removed in HAProxy %s, current version is %s: %w", kind, name, c.Until, version.String(), ErrUnsupported)
+		}
+	}
+	return nil
+}
+
+// Supports reports whether the capability is available in version
+func (r *Registry) Supports(version *semver.Version, kind Kind, name string) bool {
+	return r.Check(version, kind, name) == nil
+}
+
+// Supported returns the capabilities of a kind available in version, sorted by name
+func (r *Registry) Supported(version *semver.Version, kind Kind) []Capability {
+	r.mu.RLock()
+	list := make([]Capability, 0, len(r.capabilities[kind]))
+	for _, c := range r.capabilities[kind] {
+		list = append(list, c)
+	}
+	r.mu.RUnlock()
+
+	result := list[:0]
+	for _, c := range list {
+		if r.Supports(version, kind, c.Name) {
+			result = append(result, c)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package capabilities
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+func TestRegistry_Check(t *testing.T) {
+	r := NewRegistry(
+		Capability{Kind: Command, Name: "add server", Since: "2.6"},
+		Capability{Kind: Keyword, Name: "legacy", Since: "2.0", Until: "3.0"},
+	)
+	tests := []struct {
+		version string
+		kind    Kind
+		name    string
+		wantErr bool
+	}{
+		{version: "2.6.0", kind: Command, name: "add server"},
+		{version: "2.5.14", kind: Command, name: "add server", wantErr: true},
+		{version: "2.6-dev3", kind: Command, name: "add server"},
+		{version: "2.2.0", kind: Keyword, name: "legacy"},
+		{version: "3.0.0", kind: Keyword, name: "legacy", wantErr: true},
+		{version: "1.8.0", kind: Command, name: "unknown"},
+		{version: "2.5.14", kind: Keyword, name: "add server"},
+		{kind: Command, name: "add server"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version+" "+tt.name, func(t *testing.T) {
+			var version *semver.Version
+			if tt.version != "" {
+				version = semver.MustParse(tt.version)
+			}
+			err := r.Check(version, tt.kind, tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrUnsupported) {
+				t.Errorf("Check() error = %v, want ErrUnsupported", err)
+			}
+		})
+	}
+}
+
+func TestRegistry_Supported(t *testing.T) {
+	r := NewRegistry(
+		Capability{Kind: Keyword, Name: "crt-store", Since: "3.0"},
+		Capability{Kind: Keyword, Name: "acme", Since: "3.2"},
+		Capability{Kind: Keyword, Name: "http-errors", Since: "2.2"},
+	)
+	got := r.Supported(semver.MustParse("3.1.5"), Keyword)
+	if len(got) != 2 || got[0].Name != "crt-store" || got[1].Name != "http-errors" {
+		t.Errorf("Supported() = %v", got)
+	}
+}
@@ -0,0 +1,483 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package clientnative
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/haproxytech/client-native/v6/configuration"
+	"github.com/haproxytech/client-native/v6/misc"
+	"github.com/haproxytech/client-native/v6/models"
+	"github.com/haproxytech/client-native/v6/runtime"
+	"github.com/haproxytech/client-native/v6/storage"
+)
+
+// CertificateKind is the kind of file listed in the certificate inventory
+type CertificateKind string
+
+const (
+	CertificateKindCertificate CertificateKind = "certificate"
+	CertificateKindCAFile      CertificateKind = "ca-file"
+	CertificateKindCRLFile     CertificateKind = "crl-file"
+)
+
+// DefaultExpiryThresholds are used when a CertificateInventory is created without thresholds
+var DefaultExpiryThresholds = []time.Duration{30 * 24 * time.Hour, 7 * 24 * time.Hour, 24 * time.Hour, 0} //nolint:gochecknoglobals
+
+// CertificateInventoryEntry describes one certificate, CA or CRL file known on disk or in the running HAProxy
+type CertificateInventoryEntry struct {
+	// NotAfter is the earliest expiration date of the file, or the next update of a CRL
+	NotAfter  *time.Time
+	NotBefore *time.Time
+	Kind      CertificateKind
+	Path      string
+	Subject   string
+	Issuer    string
+	Serial    string
+	KeyType   string
+	// SubjectAlternativeNames are the DNS names and IP addresses of the certificate
+	SubjectAlternativeNames []string
+	// Binds are the binds referencing the file, as <frontend>/<bind>
+	Binds []string
+	// CrtLists are the crt-lists referencing the certificate
+	CrtLists []string
+	OnDisk   bool
+	Loaded   bool
+}
+
+// ExpiresIn returns the time left before expiration, negative when expired
+func (e *CertificateInventoryEntry) ExpiresIn(now time.Time) (time.Duration, bool) {
+	if e.NotAfter == nil {
+		return 0, false
+	}
+	return e.NotAfter.Sub(now), true
+}
+
+// CertificateExpiryAlert is sent when an inventory entry crosses an expiry threshold
+type CertificateExpiryAlert struct {
+	Entry     *CertificateInventoryEntry
+	Threshold time.Duration
+	ExpiresIn time.Duration
+}
+
+// CertificateInventory merges the certificates found in the SSL certificate storage, the
+// certificates, CA and CRL files loaded in HAProxy and the files referenced by the configuration.
+type CertificateInventory struct {
+	client HAProxyClient
+	// Notify is called by Check for each entry crossing a new threshold
+	Notify func(alert CertificateExpiryAlert)
+	// now is replaced in tests
+	now        func() time.Time
+	notified   map[string]expiryNotification
+	thresholds []time.Duration
+	mu         sync.Mutex
+}
+
+// NewCertificateInventory returns an inventory for the client. Thresholds are the
+// durations before expiration for which Notify is called, DefaultExpiryThresholds by default.
+func NewCertificateInventory(client HAProxyClient, notify func(alert CertificateExpiryAlert), thresholds ...time.Duration) *CertificateInventory {
+	if len(thresholds) == 0 {
+		thresholds = DefaultExpiryThresholds
+	}
+	t := make([]time.Duration, len(thresholds))
+	copy(t, thresholds)
+	sort.Slice(t, func(i, j int) bool { return t[i] > t[j] })
+	return &CertificateInventory{
+		client:     client,
+		Notify:     notify,
+		now:        time.Now,
+		notified:   make(map[string]expiryNotification),
+		thresholds: t,
+	}
+}
+
+// Collect returns the inventory sorted by path. Sources that are not configured
+// on the client are skipped, errors from the others are joined and returned
+// along with the entries that could be collected.
+func (i *CertificateInventory) Collect() ([]*CertificateInventoryEntry, error) {
+	inv := inventory{entries: make(map[string]*CertificateInventoryEntry)}
+
+	if certStorage, err := i.client.SSLCertStorage(); err == nil {
+		inv.collectStorage(certStorage)
+	}
+	if crtListStorage, err := i.client.CrtListStorage(); err == nil {
+		inv.collectCrtListStorage(crtListStorage)
+	}
+	if rt, err := i.client.Runtime(); err == nil {
+		inv.collectRuntime(rt)
+	}
+	if cfg, err := i.client.Configuration(); err == nil {
+		inv.collectConfiguration(cfg)
+	}
+
+	result := make([]*CertificateInventoryEntry, 0, len(inv.entries))
+	for _, e := range inv.entries {
+		sort.Strings(e.Binds)
+		sort.Strings(e.CrtLists)
+		result = append(result, e)
+	}
+	sort.Slice(result, func(a, b int) bool { return result[a].Path < result[b].Path })
+	return result, errors.Join(inv.errs...)
+}
+
+// Check collects the inventory and calls Notify for every entry that crossed a
+// threshold since the previous check. A renewed certificate is notified again
+// when it reaches a threshold.
+func (i *CertificateInventory) Check() ([]*CertificateInventoryEntry, error) {
+	entries, err := i.Collect()
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	now := i.now()
+	for _, e := range entries {
+		left, ok := e.ExpiresIn(now)
+		if !ok {
+			continue
+		}
+		key := string(e.Kind) + ":" + e.Path
+		crossed, found := i.crossedThreshold(left)
+		if !found {
+			delete(i.notified, key)
+			continue
+		}
+		if previous, ok := i.notified[key]; ok && previous.notAfter.Equal(*e.NotAfter) && previous.threshold <= crossed {
+			continue
+		}
+		i.notified[key] = expiryNotification{threshold: crossed, notAfter: *e.NotAfter}
+		if i.Notify != nil {
+			i.Notify(CertificateExpiryAlert{Entry: e, Threshold: crossed, ExpiresIn: left})
+		}
+	}
+	return entries, err
+}
+
+// crossedThreshold returns the lowest threshold reached by the time left
+func (i *CertificateInventory) crossedThreshold(left time.Duration) (time.Duration, bool) {
+	var crossed time.Duration
+	found := false
+	for _, t := range i.thresholds {
+		if left <= t {
+			crossed = t
+			found = true
+		}
+	}
+	return crossed, found
+}
+
+// expiryNotification is the last threshold notified for an entry
+type expiryNotification struct {
+	notAfter  time.Time
+	threshold time.Duration
+}
+
+type inventory struct {
+	entries map[string]*CertificateInventoryEntry
+	errs    []error
+}
+
+func (inv *inventory) get(kind CertificateKind, path string) *CertificateInventoryEntry {
+	key := string(kind) + ":" + path
+	e, ok := inv.entries[key]
+	if !ok {
+		e = &CertificateInventoryEntry{Kind: kind, Path: path}
+		inv.entries[key] = e
+	}
+	return e
+}
+
+func (inv *inventory) lookup(kind CertificateKind, path string) (*CertificateInventoryEntry, bool) {
+	e, ok := inv.entries[string(kind)+":"+path]
+	return e, ok
+}
+
+func (inv *inventory) collectStorage(certStorage storage.Storage) {
+	files, err := certStorage.GetAll()
+	if err != nil {
+		inv.errs = append(inv.errs, err)
+		return
+	}
+	for _, f := range files {
+		info, err := certStorage.GetCertificatesInfo(f)
+		if err != nil {
+			inv.errs = append(inv.errs, err)
+			continue
+		}
+		e := inv.get(CertificateKindCertificate, f)
+		e.OnDisk = true
+		e.NotAfter = info.NotAfter
+		e.NotBefore = info.NotBefore
+		e.Subject = info.Subject
+		e.Issuer = info.Issuers
+		e.Serial = info.Serial
+		e.KeyType = info.KeyType
+		e.SubjectAlternativeNames = splitList(info.DNS, info.IPs)
+	}
+}
+
+func (inv *inventory) collectCrtListStorage(crtListStorage storage.Storage) {
+	files, err := crtListStorage.GetAll()
+	if err != nil {
+		inv.errs = append(inv.errs, err)
+		return
+	}
+	for _, f := range files {
+		contents, err := crtListStorage.GetContents(filepath.Base(f))
+		if err != nil {
+			inv.errs = append(inv.errs, err)
+			continue
+		}
+		entries, _ := runtime.ParseCrtListEntries(contents)
+		inv.addCrtListReferences(f, entries)
+	}
+}
+
+func (inv *inventory) addCrtListReferences(crtList string, entries models.SslCrtListEntries) {
+	for _, entry := range entries {
+		if entry == nil {
+			continue
+		}
+		e := inv.get(CertificateKindCertificate, entry.File)
+		e.CrtLists = appendUnique(e.CrtLists, crtList)
+	}
+}
+
+func (inv *inventory) collectRuntime(rt runtime.Runtime) { //nolint:gocognit
+	if certs, err := rt.ShowCerts(); err == nil {
+		for _, c := range certs {
+			cert, err := rt.ShowCertificate(c.StorageName)
+			if err != nil {
+				inv.errs = append(inv.errs, err)
+				continue
+			}
+			e := inv.get(CertificateKindCertificate, c.StorageName)
+			e.Loaded = true
+			if e.NotAfter == nil {
+				mergeRuntimeCertificate(e, cert)
+			}
+		}
+	} else {
+		inv.errs = append(inv.errs, err)
+	}
+
+	if crtLists, err := rt.ShowCrtLists(); err == nil {
+		for _, l := range crtLists {
+			entries, err := rt.ShowCrtListEntries(l.File)
+			if err != nil {
+				continue
+			}
+			inv.addCrtListReferences(l.File, entries)
+		}
+	}
+
+	if caFiles, err := rt.ShowCAFiles(); err == nil {
+		for _, ca := range caFiles {
+			e := inv.get(CertificateKindCAFile, ca.StorageName)
+			e.Loaded = true
+			count, _ := strconv.ParseInt(ca.Count, 10, 64)
+			for idx := range count {
+				cert, err := rt.ShowCAFile(ca.StorageName, &idx)
+				if err != nil {
+					inv.errs = append(inv.errs, err)
+					break
+				}
+				if e.NotAfter == nil || (cert.NotAfter != nil && time.Time(*cert.NotAfter).Before(*e.NotAfter)) {
+					mergeRuntimeCertificate(e, cert)
+				}
+			}
+		}
+	}
+
+	if crls, err := rt.ShowCrlFiles(); err == nil {
+		for _, crl := range crls {
+			e := inv.get(CertificateKindCRLFile, crl.StorageName)
+			e.Loaded = true
+			entries, err := rt.ShowCrlFile(crl.StorageName, nil)
+			if err != nil || entries == nil {
+				continue
+			}
+			for _, entry := range *entries {
+				if entry == nil {
+					continue
+				}
+				next := time.Time(entry.NextUpdate)
+				if !next.IsZero() && (e.NotAfter == nil || next.Before(*e.NotAfter)) {
+					e.NotAfter = &next
+				}
+				e.Issuer = entry.Issuer
+			}
+		}
+	}
+}
+
+func mergeRuntimeCertificate(e *CertificateInventoryEntry, cert *models.SslCertificate) {
+	if cert.NotAfter != nil {
+		t := time.Time(*cert.NotAfter)
+		e.NotAfter = &t
+	}
+	if cert.NotBefore != nil {
+		t := time.Time(*cert.NotBefore)
+		e.NotBefore = &t
+	}
+	e.Subject = cert.Subject
+	e.Issuer = cert.Issuers
+	e.Serial = cert.Serial
+	e.KeyType = cert.Algorithm
+	var sans []string
+	for _, san := range strings.Split(cert.SubjectAlternativeNames, ",") {
+		san = strings.TrimSpace(san)
+		san = strings.TrimPrefix(san, "DNS:")
+		san = strings.TrimPrefix(san, "IP Address:")
+		if san != "" {
+			sans = append(sans, san)
+		}
+	}
+	e.SubjectAlternativeNames = sans
+}
+
+func (inv *inventory) collectConfiguration(cfg configuration.Configuration) {
+	_, frontends, err := cfg.GetFrontends("")
+	if err != nil {
+		inv.errs = append(inv.errs, err)
+		return
+	}
+	for _, f := range frontends {
+		_, binds, err := cfg.GetBinds(configuration.FrontendParentName, f.Name, "")
+		if err != nil {
+			inv.errs = append(inv.errs, err)
+			continue
+		}
+		for _, b := range binds {
+			ref := f.Name + "/" + b.Name
+			if b.SslCertificate != "" {
+				inv.addBindCertificateReference(b.SslCertificate, ref)
+			}
+			if b.CrtList != "" {
+				for _, e := range inv.entries {
+					if e.Kind == CertificateKindCertificate && misc.StringInSlice(b.CrtList, e.CrtLists) {
+						e.Binds = appendUnique(e.Binds, ref)
+					}
+				}
+			}
+			for _, ca := range []string{b.SslCafile, b.CaVerifyFile} {
+				if ca != "" {
+					inv.addFileReference(CertificateKindCAFile, ca, ref)
+				}
+			}
+			if b.CrlFile != "" {
+				inv.addFileReference(CertificateKindCRLFile, b.CrlFile, ref)
+			}
+		}
+	}
+}
+
+// addBindCertificateReference marks the certificate, or all certificates of the
+// directory, given to a bind `crt` keyword as used by the bind
+func (inv *inventory) addBindCertificateReference(crt, ref string) {
+	matched := false
+	for _, e := range inv.entries {
+		if e.Kind != CertificateKindCertificate {
+			continue
+		}
+		if e.Path == crt || filepath.Dir(e.Path) == filepath.Clean(crt) {
+			e.Binds = appendUnique(e.Binds, ref)
+			matched = true
+		}
+	}
+	if !matched {
+		inv.addFileReference(CertificateKindCertificate, crt, ref)
+	}
+}
+
+// addFileReference adds a bind reference to a file, reading it from disk if it is not known yet
+func (inv *inventory) addFileReference(kind CertificateKind, path, ref string) {
+	e, known := inv.lookup(kind, path)
+	if !known {
+		e = inv.get(kind, path)
+		raw, err := os.ReadFile(path)
+		if err == nil {
+			e.OnDisk = true
+			inv.parseFile(e, raw)
+		}
+	}
+	e.Binds = appendUnique(e.Binds, ref)
+}
+
+func (inv *inventory) parseFile(e *CertificateInventoryEntry, raw []byte) {
+	if e.Kind != CertificateKindCRLFile {
+		info, err := storage.ParseCertificatesInfo(raw)
+		if err != nil {
+			inv.errs = append(inv.errs, err)
+			return
+		}
+		e.NotAfter = info.NotAfter
+		e.NotBefore = info.NotBefore
+		e.Subject = info.Subject
+		e.Issuer = info.Issuers
+		e.Serial = info.Serial
+		e.KeyType = info.KeyType
+		e.SubjectAlternativeNames = splitList(info.DNS, info.IPs)
+		return
+	}
+	for {
+		block, rest := pem.Decode(raw)
+		if block == nil {
+			break
+		}
+		if block.Type == "X509 CRL" {
+			crl, err := x509.ParseRevocationList(block.Bytes)
+			if err != nil {
+				inv.errs = append(inv.errs, err)
+				return
+			}
+			if !crl.NextUpdate.IsZero() && (e.NotAfter == nil || crl.NextUpdate.Before(*e.NotAfter)) {
+				next := crl.NextUpdate
+				e.NotAfter = &next
+			}
+			e.Issuer = crl.Issuer.String()
+		}
+		raw = rest
+	}
+}
+
+func appendUnique(list []string, value string) []string {
+	if misc.StringInSlice(value, list) {
+		return list
+	}
+	return append(list, value)
+}
+
+func splitList(lists ...string) []string {
+	var result []string
+	for _, l := range lists {
+		for _, v := range strings.Split(l, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				result = append(result, v)
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
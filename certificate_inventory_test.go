@@ -0,0 +1,87 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package clientnative
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/haproxytech/client-native/v6/options"
+	"github.com/haproxytech/client-native/v6/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificateInventory_Check(t *testing.T) {
+	raw, err := os.ReadFile("storage/test-certs/valid/selfsigned1.pem")
+	require.NoError(t, err)
+
+	certDir := t.TempDir()
+	certPath := filepath.Join(certDir, "site.pem")
+	require.NoError(t, os.WriteFile(certPath, raw, 0o644))
+	certStorage, err := storage.New(certDir, storage.SSLType)
+	require.NoError(t, err)
+
+	crtListDir := t.TempDir()
+	crtListPath := filepath.Join(crtListDir, "list.txt")
+	require.NoError(t, os.WriteFile(crtListPath, []byte(certPath+" [alpn h2] example.com\n"), 0o644))
+	crtListStorage, err := storage.New(crtListDir, storage.CrtListType)
+	require.NoError(t, err)
+
+	client, err := New(context.Background(), options.SSLCertStorage(certStorage), options.CrtListStorage(crtListStorage))
+	require.NoError(t, err)
+
+	var alerts []CertificateExpiryAlert
+	inv := NewCertificateInventory(client, func(alert CertificateExpiryAlert) {
+		alerts = append(alerts, alert)
+	})
+
+	entries, err := inv.Collect()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	entry := entries[0]
+	require.Equal(t, certPath, entry.Path)
+	require.True(t, entry.OnDisk)
+	require.Equal(t, []string{crtListPath}, entry.CrtLists)
+	require.NotNil(t, entry.NotAfter)
+	require.NotEmpty(t, entry.KeyType)
+
+	notAfter := *entry.NotAfter
+
+	inv.now = func() time.Time { return notAfter.Add(-60 * 24 * time.Hour) }
+	_, err = inv.Check()
+	require.NoError(t, err)
+	require.Empty(t, alerts)
+
+	inv.now = func() time.Time { return notAfter.Add(-5 * 24 * time.Hour) }
+	_, err = inv.Check()
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	require.Equal(t, 7*24*time.Hour, alerts[0].Threshold)
+
+	// the same threshold is notified only once
+	_, err = inv.Check()
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+
+	inv.now = func() time.Time { return notAfter.Add(time.Hour) }
+	_, err = inv.Check()
+	require.NoError(t, err)
+	require.Len(t, alerts, 2)
+	require.Equal(t, time.Duration(0), alerts[1].Threshold)
+}
@@ -0,0 +1,255 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package clientnative
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/haproxytech/client-native/v6/models"
+	"github.com/haproxytech/client-native/v6/runtime"
+	"github.com/haproxytech/client-native/v6/storage"
+)
+
+// CertificateRotation describes a certificate to install or replace
+type CertificateRotation struct {
+	// CrtListEntries are the crt-list entries that must reference the certificate,
+	// keyed by crt-list file name in the crt-list storage. An empty entry File
+	// defaults to the certificate path.
+	CrtListEntries map[string]models.SslCrtListEntry
+	// Name is the certificate file name in the SSL certificate storage
+	Name string
+	// Payload is the PEM bundle with the certificate, its chain and its private key
+	Payload string
+}
+
+// CertificateManager rotates certificates on disk and in the running HAProxy as one operation
+type CertificateManager struct {
+	client HAProxyClient
+	// Roots, when set, is used to verify the chain of rotated certificates.
+	// Otherwise only the consistency of the bundle itself is checked.
+	Roots *x509.CertPool
+	mu    sync.Mutex
+}
+
+// NewCertificateManager returns a certificate manager using the storages and runtime of the client
+func NewCertificateManager(client HAProxyClient) *CertificateManager {
+	return &CertificateManager{client: client}
+}
+
+// rollback keeps the actions undoing the steps already performed
+type rollback []func() error
+
+func (r *rollback) add(fn func() error) {
+	*r = append(*r, fn)
+}
+
+func (r rollback) run() error {
+	var errs []error
+	for i := len(r) - 1; i >= 0; i-- {
+		if err := r[i](); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Rotate validates the certificate, writes it to the SSL certificate storage, loads it in
+// the running HAProxy with a `set ssl cert` transaction and adds the requested crt-list
+// entries. If any step fails, the previous file, runtime certificate and crt-lists are restored.
+// Runtime steps are skipped when the client has no runtime. Returns the certificate path.
+func (m *CertificateManager) Rotate(rotation CertificateRotation) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := storage.ValidateCertificateBundle([]byte(rotation.Payload), m.Roots); err != nil {
+		return "", fmt.Errorf("invalid certificate %s: %w", rotation.Name, err)
+	}
+
+	certStorage, err := m.client.SSLCertStorage()
+	if err != nil {
+		return "", err
+	}
+	rt, _ := m.client.Runtime()
+
+	var undo rollback
+	fail := func(err error) (string, error) {
+		if rbErr := undo.run(); rbErr != nil {
+			return "", fmt.Errorf("certificate %s rotation failed: %w, rollback failed: %w", rotation.Name, err, rbErr)
+		}
+		return "", fmt.Errorf("certificate %s rotation failed: %w", rotation.Name, err)
+	}
+
+	path, err := m.writeCertificate(certStorage, rotation, &undo)
+	if err != nil {
+		return fail(err)
+	}
+
+	if rt != nil {
+		if err = m.commitRuntimeCertificate(rt, path, rotation.Payload, &undo); err != nil {
+			return fail(err)
+		}
+	}
+
+	if len(rotation.CrtListEntries) > 0 {
+		var crtListStorage storage.Storage
+		crtListStorage, err = m.client.CrtListStorage()
+		if err != nil {
+			return fail(err)
+		}
+		crtLists := make([]string, 0, len(rotation.CrtListEntries))
+		for name := range rotation.CrtListEntries {
+			crtLists = append(crtLists, name)
+		}
+		sort.Strings(crtLists)
+		for _, name := range crtLists {
+			entry := rotation.CrtListEntries[name]
+			if entry.File == "" {
+				entry.File = path
+			}
+			if err = m.updateCrtList(crtListStorage, rt, name, entry, &undo); err != nil {
+				return fail(err)
+			}
+		}
+	}
+
+	return path, nil
+}
+
+func (m *CertificateManager) writeCertificate(certStorage storage.Storage, rotation CertificateRotation, undo *rollback) (string, error) {
+	if _, _, err := certStorage.Get(rotation.Name); err != nil {
+		path, _, err := certStorage.Create(rotation.Name, io.NopCloser(strings.NewReader(rotation.Payload)))
+		if err != nil {
+			return "", err
+		}
+		undo.add(func() error { return certStorage.Delete(rotation.Name) })
+		return path, nil
+	}
+
+	previous, err := certStorage.GetContents(rotation.Name)
+	if err != nil {
+		return "", err
+	}
+	path, err := certStorage.Replace(rotation.Name, rotation.Payload)
+	if err != nil {
+		return "", err
+	}
+	undo.add(func() error {
+		_, err := certStorage.Replace(rotation.Name, previous)
+		return err
+	})
+	return path, nil
+}
+
+func (m *CertificateManager) commitRuntimeCertificate(rt runtime.Runtime, path, payload string, undo *rollback) error {
+	if _, err := rt.GetCert(path); err != nil {
+		if err = rt.NewCertEntry(path); err != nil {
+			return err
+		}
+		undo.add(func() error { return rt.DeleteCertEntry(path) })
+	} else {
+		previous, err := rt.DumpCertificate(path)
+		if err != nil {
+			return fmt.Errorf("cannot save running certificate: %w", err)
+		}
+		undo.add(func() error { return setRuntimeCertificate(rt, path, previous) })
+	}
+	return setRuntimeCertificate(rt, path, payload)
+}
+
+func setRuntimeCertificate(rt runtime.Runtime, path, payload string) error {
+	if err := rt.SetCertEntry(path, payload); err != nil {
+		_ = rt.AbortCertEntry(path)
+		return err
+	}
+	if err := rt.CommitCertEntry(path); err != nil {
+		_ = rt.AbortCertEntry(path)
+		return err
+	}
+	return nil
+}
+
+func (m *CertificateManager) updateCrtList(crtListStorage storage.Storage, rt runtime.Runtime, name string, entry models.SslCrtListEntry, undo *rollback) error {
+	crtListPath, _, err := crtListStorage.Get(name)
+	if err != nil {
+		return err
+	}
+	previous, err := crtListStorage.GetContents(name)
+	if err != nil {
+		return err
+	}
+
+	entries, _ := runtime.ParseCrtListEntries(previous)
+	if !hasCrtListEntry(entries, entry.File) {
+		contents := previous
+		if contents != "" && !strings.HasSuffix(contents, "\n") {
+			contents += "\n"
+		}
+		if _, err = crtListStorage.Replace(name, contents+crtListLine(entry)+"\n"); err != nil {
+			return err
+		}
+		undo.add(func() error {
+			_, err := crtListStorage.Replace(name, previous)
+			return err
+		})
+	}
+
+	if rt == nil {
+		return nil
+	}
+	entries, err = rt.ShowCrtListEntries(crtListPath)
+	if err != nil {
+		return err
+	}
+	if hasCrtListEntry(entries, entry.File) {
+		return nil
+	}
+	if err = rt.AddCrtListEntry(crtListPath, entry); err != nil {
+		return err
+	}
+	undo.add(func() error { return rt.DeleteCrtListEntry(crtListPath, entry.File, nil) })
+	return nil
+}
+
+func hasCrtListEntry(entries models.SslCrtListEntries, file string) bool {
+	for _, e := range entries {
+		if e != nil && e.File == file {
+			return true
+		}
+	}
+	return false
+}
+
+// crtListLine returns a crt-list entry as written in a crt-list file
+func crtListLine(entry models.SslCrtListEntry) string {
+	var sb strings.Builder
+	sb.WriteString(entry.File)
+	if entry.SSLBindConfig != "" {
+		sb.WriteString(" [")
+		sb.WriteString(entry.SSLBindConfig)
+		sb.WriteByte(']')
+	}
+	if len(entry.SNIFilter) > 0 {
+		sb.WriteByte(' ')
+		sb.WriteString(strings.Join(entry.SNIFilter, " "))
+	}
+	return sb.String()
+}
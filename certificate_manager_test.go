@@ -0,0 +1,113 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package clientnative
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/models"
+	"github.com/haproxytech/client-native/v6/options"
+	"github.com/haproxytech/client-native/v6/runtime"
+	"github.com/haproxytech/client-native/v6/storage"
+	"github.com/stretchr/testify/require"
+)
+
+type certManagerRuntimeMock struct {
+	runtime.Runtime
+
+	certs      map[string]string
+	pending    map[string]string
+	commitFail bool
+}
+
+func (r *certManagerRuntimeMock) GetCert(name string) (*models.SslCertificate, error) {
+	if _, ok := r.certs[name]; !ok {
+		return nil, errors.New("not found")
+	}
+	return &models.SslCertificate{StorageName: name}, nil
+}
+
+func (r *certManagerRuntimeMock) DumpCertificate(name string) (string, error) {
+	return r.certs[name], nil
+}
+
+func (r *certManagerRuntimeMock) SetCertEntry(name, payload string) error {
+	r.pending[name] = payload
+	return nil
+}
+
+func (r *certManagerRuntimeMock) AbortCertEntry(name string) error {
+	delete(r.pending, name)
+	return nil
+}
+
+func (r *certManagerRuntimeMock) CommitCertEntry(name string) error {
+	if r.commitFail {
+		r.commitFail = false
+		return errors.New("commit failed")
+	}
+	r.certs[name] = r.pending[name]
+	delete(r.pending, name)
+	return nil
+}
+
+func TestCertificateManager_Rotate(t *testing.T) {
+	oldPEM, err := os.ReadFile("storage/test-certs/valid/selfsigned1.pem")
+	require.NoError(t, err)
+	newPEM, err := os.ReadFile("storage/test-certs/valid/OK-crt_key_int1_int2.pem")
+	require.NoError(t, err)
+	invalidPEM, err := os.ReadFile("storage/test-certs/invalid/NOK-crt_key_int1.pem")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "site.pem")
+	require.NoError(t, os.WriteFile(certPath, oldPEM, 0o644))
+	certStorage, err := storage.New(dir, storage.SSLType)
+	require.NoError(t, err)
+
+	rt := &certManagerRuntimeMock{
+		certs:   map[string]string{certPath: string(oldPEM)},
+		pending: map[string]string{},
+	}
+	client, err := New(context.Background(), options.SSLCertStorage(certStorage), options.Runtime(rt))
+	require.NoError(t, err)
+	m := NewCertificateManager(client)
+
+	// an incomplete chain is refused before anything is changed
+	_, err = m.Rotate(CertificateRotation{Name: "site.pem", Payload: string(invalidPEM)})
+	require.Error(t, err)
+
+	// a failed runtime commit restores the previous file
+	rt.commitFail = true
+	_, err = m.Rotate(CertificateRotation{Name: "site.pem", Payload: string(newPEM)})
+	require.Error(t, err)
+	onDisk, err := os.ReadFile(certPath)
+	require.NoError(t, err)
+	require.Equal(t, string(oldPEM), string(onDisk))
+	require.Equal(t, string(oldPEM), rt.certs[certPath])
+
+	path, err := m.Rotate(CertificateRotation{Name: "site.pem", Payload: string(newPEM)})
+	require.NoError(t, err)
+	require.Equal(t, certPath, path)
+	onDisk, err = os.ReadFile(certPath)
+	require.NoError(t, err)
+	require.Equal(t, string(newPEM), string(onDisk))
+	require.Equal(t, string(newPEM), rt.certs[certPath])
+}
@@ -0,0 +1,438 @@
+/*
+Copyright 2026 HAProxy Technologies
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conditions evaluates the .if conditions of HAProxy configurations
+package conditions
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/haproxytech/client-native/v6/config-parser/types"
+	"github.com/haproxytech/client-native/v6/misc"
+)
+
+// Environment is what conditions are evaluated against
+type Environment struct {
+	// Version is the HAProxy version, for version_atleast() and version_before()
+	Version string
+	// Features are the features HAProxy is built with, as in its feature list without the + sign
+	Features []string
+	// Enabled are the options enabled at runtime, like POLL or SPLICE, for enabled()
+	Enabled []string
+	// OpenSSLVersion is the version of the SSL library, for openssl_version_atleast() and openssl_version_before()
+	OpenSSLVersion string
+	// SSLLibName is the name of the SSL library, for ssllib_name_startswith()
+	SSLLibName string
+	// Env are the environment variables, the environment of the process is used when nil
+	Env map[string]string
+}
+
+var ErrInvalidCondition = errors.New("invalid condition")
+
+var ErrUnbalancedBlock = errors.New("unbalanced conditional block")
+
+func (e Environment) lookup(name string) (string, bool) {
+	if e.Env == nil {
+		return os.LookupEnv(name)
+	}
+	value, ok := e.Env[name]
+	return value, ok
+}
+
+// Evaluate evaluates a .if condition, the text following .if or .elif
+func Evaluate(condition string, env Environment) (bool, error) {
+	e := &evaluator{text: condition, env: env}
+	e.skipSpaces()
+	if e.done() {
+		// an empty condition is false
+		return false, nil
+	}
+	result, err := e.expr()
+	if err != nil {
+		return false, fmt.Errorf("%w '%s': %w", ErrInvalidCondition, condition, err)
+	}
+	e.skipSpaces()
+	if !e.done() {
+		return false, fmt.Errorf("%w '%s': unexpected '%s'", ErrInvalidCondition, condition, e.text[e.pos:])
+	}
+	return result, nil
+}
+
+// Effective returns the configuration HAProxy uses, with the lines of the branches evaluated to false and
+// the conditional and directive lines removed, along with the directives it emits
+func Effective(config string, env Environment) (string, []types.Directive, error) {
+	type frame struct {
+		// parent is true when the enclosing block is active
+		parent bool
+		active bool
+		taken  bool
+		isElse bool
+	}
+	stack := []*frame{}
+	active := func() bool {
+		return len(stack) == 0 || stack[len(stack)-1].active
+	}
+
+	var result strings.Builder
+	directives := []types.Directive{}
+	for number, line := range strings.SplitAfter(config, "\n") {
+		fields := strings.Fields(line)
+		keyword := ""
+		if len(fields) > 0 {
+			keyword = fields[0]
+		}
+		condition := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), keyword))
+		switch keyword {
+		case ".if":
+			f := &frame{parent: active()}
+			if f.parent {
+				value, err := Evaluate(condition, env)
+				if err != nil {
+					return "", nil, fmt.Errorf("line %d: %w", number+1, err)
+				}
+				f.active = value
+				f.taken = value
+			}
+			stack = append(stack, f)
+		case ".elif":
+			if len(stack) == 0 || stack[len(stack)-1].isElse {
+				return "", nil, fmt.Errorf("line %d: %w: unexpected .elif", number+1, ErrUnbalancedBlock)
+			}
+			f := stack[len(stack)-1]
+			f.active = false
+			if f.parent && !f.taken {
+				value, err := Evaluate(condition, env)
+				if err != nil {
+					return "", nil, fmt.Errorf("line %d: %w", number+1, err)
+				}
+				f.active = value
+				f.taken = value
+			}
+		case ".else":
+			if len(stack) == 0 || stack[len(stack)-1].isElse {
+				return "", nil, fmt.Errorf("line %d: %w: unexpected .else", number+1, ErrUnbalancedBlock)
+			}
+			f := stack[len(stack)-1]
+			f.active = f.parent && !f.taken
+			f.taken = true
+			f.isElse = true
+		case ".endif":
+			if len(stack) == 0 {
+				return "", nil, fmt.Errorf("line %d: %w: unexpected .endif", number+1, ErrUnbalancedBlock)
+			}
+			stack = stack[:len(stack)-1]
+		case ".diag", ".notice", ".warning", ".alert":
+			if active() {
+				directives = append(directives, types.Directive{
+					Keyword: keyword,
+					Message: unquoteWord(misc.ExpandEnvironment(condition, env.lookup)),
+				})
+			}
+		default:
+			if active() {
+				result.WriteString(line)
+			}
+		}
+	}
+	if len(stack) > 0 {
+		return "", nil, fmt.Errorf("%w: missing .endif", ErrUnbalancedBlock)
+	}
+	return result.String(), directives, nil
+}
+
+type evaluator struct {
+	text string
+	pos  int
+	env  Environment
+}
+
+func (e *evaluator) done() bool {
+	return e.pos >= len(e.text)
+}
+
+func (e *evaluator) skipSpaces() {
+	for !e.done() && (e.text[e.pos] == ' ' || e.text[e.pos] == '\t') {
+		e.pos++
+	}
+	// the rest of the line is a comment
+	if !e.done() && e.text[e.pos] == '#' {
+		e.text = e.text[:e.pos]
+	}
+}
+
+func (e *evaluator) consume(token string) bool {
+	e.skipSpaces()
+	if strings.HasPrefix(e.text[e.pos:], token) {
+		e.pos += len(token)
+		return true
+	}
+	return false
+}
+
+// expr is a list of terms separated by && and ||, && having the highest precedence
+func (e *evaluator) expr() (bool, error) {
+	result, err := e.and()
+	if err != nil {
+		return false, err
+	}
+	for e.consume("||") {
+		value, err := e.and()
+		if err != nil {
+			return false, err
+		}
+		result = result || value
+	}
+	return result, nil
+}
+
+func (e *evaluator) and() (bool, error) {
+	result, err := e.term()
+	if err != nil {
+		return false, err
+	}
+	for e.consume("&&") {
+		value, err := e.term()
+		if err != nil {
+			return false, err
+		}
+		result = result && value
+	}
+	return result, nil
+}
+
+func (e *evaluator) term() (bool, error) {
+	e.skipSpaces()
+	if e.done() {
+		return false, errors.New("missing term")
+	}
+	switch c := e.text[e.pos]; {
+	case c == '!':
+		e.pos++
+		value, err := e.term()
+		return !value, err
+	case c == '(':
+		e.pos++
+		value, err := e.expr()
+		if err != nil {
+			return false, err
+		}
+		if !e.consume(")") {
+			return false, errors.New("missing ')'")
+		}
+		return value, nil
+	case c == '"' || c == '\'' || (c >= '0' && c <= '9'):
+		// a string or a number, true when it is a number other than 0
+		value := e.word()
+		if value == "" {
+			return false, nil
+		}
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return false, fmt.Errorf("'%s' is not a number", value)
+		}
+		return number != 0, nil
+	}
+
+	start := e.pos
+	for !e.done() && (isLetter(e.text[e.pos]) || e.text[e.pos] == '_' || (e.text[e.pos] >= '0' && e.text[e.pos] <= '9')) {
+		e.pos++
+	}
+	name := e.text[start:e.pos]
+	if name == "" {
+		return false, fmt.Errorf("unexpected '%s'", e.text[e.pos:])
+	}
+	if !e.consume("(") {
+		return false, fmt.Errorf("missing arguments of %s", name)
+	}
+	args := []string{}
+	for !e.consume(")") {
+		if e.done() {
+			return false, fmt.Errorf("missing ')' after arguments of %s", name)
+		}
+		if len(args) > 0 && !e.consume(",") {
+			return false, fmt.Errorf("missing ',' between arguments of %s", name)
+		}
+		args = append(args, e.word())
+	}
+	return e.predicate(name, args)
+}
+
+// word reads a word up to a separator, expanding the environment variables of double quoted parts
+func (e *evaluator) word() string {
+	e.skipSpaces()
+	start := e.pos
+	quote := byte(0)
+	for ; !e.done(); e.pos++ {
+		c := e.text[e.pos]
+		if quote != 0 {
+			if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		if c == '"' || c == '\'' {
+			quote = c
+			continue
+		}
+		if c == ',' || c == ')' || c == ' ' || c == '\t' || c == '&' || c == '|' {
+			break
+		}
+	}
+	return unquoteWord(misc.ExpandEnvironment(e.text[start:e.pos], e.env.lookup))
+}
+
+func (e *evaluator) predicate(name string, args []string) (bool, error) {
+	arg := func(i int) string {
+		if i < len(args) {
+			return args[i]
+		}
+		return ""
+	}
+	want := map[string]int{
+		"defined": 1, "feature": 1, "streq": 2, "strneq": 2, "strstr": 2,
+		"version_atleast": 1, "version_before": 1, "openssl_version_atleast": 1, "openssl_version_before": 1,
+		"ssllib_name_startswith": 1, "enabled": 1,
+	}
+	count, ok := want[name]
+	if !ok {
+		return false, fmt.Errorf("unknown predicate %s", name)
+	}
+	if len(args) != count {
+		return false, fmt.Errorf("%s expects %d arguments, got %d", name, count, len(args))
+	}
+
+	switch name {
+	case "defined":
+		_, ok := e.env.lookup(arg(0))
+		return ok, nil
+	case "feature":
+		return slices.ContainsFunc(e.env.Features, func(f string) bool {
+			return strings.TrimPrefix(f, "+") == arg(0)
+		}), nil
+	case "streq":
+		return arg(0) == arg(1), nil
+	case "strneq":
+		return arg(0) != arg(1), nil
+	case "strstr":
+		return strings.Contains(arg(0), arg(1)), nil
+	case "version_atleast":
+		return CompareVersions(e.env.Version, arg(0)) >= 0, nil
+	case "version_before":
+		return CompareVersions(e.env.Version, arg(0)) < 0, nil
+	case "openssl_version_atleast":
+		return CompareVersions(e.env.OpenSSLVersion, arg(0)) >= 0, nil
+	case "openssl_version_before":
+		return CompareVersions(e.env.OpenSSLVersion, arg(0)) < 0, nil
+	case "ssllib_name_startswith":
+		return len(e.env.SSLLibName) >= len(arg(0)) && strings.EqualFold(e.env.SSLLibName[:len(arg(0))], arg(0)), nil
+	default: // enabled
+		return slices.ContainsFunc(e.env.Enabled, func(o string) bool {
+			return strings.EqualFold(o, arg(0))
+		}), nil
+	}
+}
+
+// unquoteWord removes the quotes and the escaping backslashes of a word
+func unquoteWord(word string) string {
+	var result strings.Builder
+	quote := byte(0)
+	for i := 0; i < len(word); i++ {
+		c := word[i]
+		switch {
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case quote != 0 && c == quote:
+			quote = 0
+		case c == '\\' && quote != '\'' && i+1 < len(word):
+			i++
+			result.WriteByte(word[i])
+		default:
+			result.WriteByte(c)
+		}
+	}
+	return result.String()
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// CompareVersions compares two versions like 2.8, 3.0.5 or 2.9-dev3, a development version being
+// older than its release. It returns -1, 0 or 1 as a is older than, the same as or newer than b.
+func CompareVersions(a, b string) int {
+	aNumbers, aDev := parseVersion(a)
+	bNumbers, bDev := parseVersion(b)
+	for i := range max(len(aNumbers), len(bNumbers)) {
+		var x, y int64
+		if i < len(aNumbers) {
+			x = aNumbers[i]
+		}
+		if i < len(bNumbers) {
+			y = bNumbers[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case aDev == bDev:
+		return 0
+	// a release is newer than its development versions
+	case aDev < 0:
+		return 1
+	case bDev < 0:
+		return -1
+	case aDev < bDev:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// parseVersion returns the numbers of a version and its development number, -1 for releases
+func parseVersion(version string) ([]int64, int64) {
+	numbers := []int64{}
+	rest := version
+	for rest != "" {
+		end := 0
+		for end < len(rest) && rest[end] >= '0' && rest[end] <= '9' {
+			end++
+		}
+		if end == 0 {
+			break
+		}
+		n, _ := strconv.ParseInt(rest[:end], 10, 64)
+		numbers = append(numbers, n)
+		rest = rest[end:]
+		if !strings.HasPrefix(rest, ".") {
+			break
+		}
+		rest = rest[1:]
+	}
+	dev := int64(-1)
+	if after, ok := strings.CutPrefix(rest, "-dev"); ok {
+		dev, _ = strconv.ParseInt(after, 10, 64)
+	}
+	return numbers, dev
+}
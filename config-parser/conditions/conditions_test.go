@@ -0,0 +1,145 @@
+/*
+Copyright 2026 HAProxy Technologies
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions //nolint:testpackage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/config-parser/types"
+)
+
+func TestEvaluate(t *testing.T) {
+	env := Environment{
+		Version:        "3.0.5",
+		Features:       []string{"+QUIC", "OPENSSL"},
+		Enabled:        []string{"POLL"},
+		OpenSSLVersion: "3.0.13",
+		SSLLibName:     "OpenSSL",
+		Env:            map[string]string{"ENV": "prod", "EMPTY": ""},
+	}
+	tests := []struct {
+		condition string
+		want      bool
+	}{
+		{"", false},
+		{"1", true},
+		{"0", false},
+		{`""`, false},
+		{"defined(ENV)", true},
+		{"defined(MISSING)", false},
+		{"defined(EMPTY)", true},
+		{"feature(QUIC)", true},
+		{"feature(LUA)", false},
+		{`streq("$ENV",prod)`, true},
+		{`streq("${ENV}", "prod")`, true},
+		{`strneq("$ENV",prod)`, false},
+		{`streq("${MISSING-dev}",dev)`, true},
+		{`streq("${EMPTY-dev}",dev)`, false},
+		{`streq("${EMPTY:-dev}",dev)`, true},
+		{`strstr("production","$ENV")`, true},
+		{"version_atleast(3.0)", true},
+		{"version_atleast(3.0.6)", false},
+		{"version_before(3.1-dev2)", true},
+		{"openssl_version_atleast(3.0.0)", true},
+		{"openssl_version_before(1.1.1)", false},
+		{"ssllib_name_startswith(openssl)", true},
+		{"enabled(POLL)", true},
+		{"enabled(SPLICE)", false},
+		{"!feature(LUA)", true},
+		{"feature(QUIC) && feature(LUA)", false},
+		{"feature(LUA) || feature(QUIC)", true},
+		{"feature(LUA) || feature(QUIC) && defined(MISSING)", false},
+		{"(feature(LUA) || feature(QUIC)) && defined(ENV)", true},
+		{"feature(QUIC) # QUIC only", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			got, err := Evaluate(tt.condition, env)
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Evaluate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluate_Errors(t *testing.T) {
+	for _, condition := range []string{"unknown(A)", "defined(A", "defined(A,B)", "feature(QUIC) &&", "abc", "(1"} {
+		t.Run(condition, func(t *testing.T) {
+			if _, err := Evaluate(condition, Environment{}); !errors.Is(err, ErrInvalidCondition) {
+				t.Errorf("Evaluate() error = %v, want ErrInvalidCondition", err)
+			}
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2.8", "2.8.0", 0},
+		{"2.8.1", "2.8", 1},
+		{"2.9-dev3", "2.9", -1},
+		{"2.9-dev3", "2.9-dev10", -1},
+		{"3.0", "2.9.7", 1},
+	}
+	for _, tt := range tests {
+		if got := CompareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareVersions(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestEffective(t *testing.T) {
+	config := `global
+  .if defined(A)
+    .if defined(B)
+      a-and-b
+    .else
+      a-only
+    .endif
+    .notice "A is $A"
+  .elif defined(B)
+    b-only
+  .else
+    .warning "neither A nor B"
+  .endif
+  daemon
+`
+	env := Environment{Env: map[string]string{"A": "1"}}
+	result, directives, err := Effective(config, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "global\n      a-only\n  daemon\n"; result != want {
+		t.Errorf("Effective() = %q, want %q", result, want)
+	}
+	if len(directives) != 1 || directives[0] != (types.Directive{Keyword: ".notice", Message: "A is 1"}) {
+		t.Errorf("directives = %v, want the .notice", directives)
+	}
+
+	if _, _, err = Effective("global\n.if 1\n", env); !errors.Is(err, ErrUnbalancedBlock) {
+		t.Errorf("Effective() of an unterminated block error = %v, want ErrUnbalancedBlock", err)
+	}
+	if _, _, err = Effective("global\n.else\n", env); !errors.Is(err, ErrUnbalancedBlock) {
+		t.Errorf("Effective() of a stray .else error = %v, want ErrUnbalancedBlock", err)
+	}
+}
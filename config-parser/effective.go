@@ -0,0 +1,28 @@
+/*
+Copyright 2026 HAProxy Technologies
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parser
+
+import (
+	"github.com/haproxytech/client-native/v6/config-parser/conditions"
+	"github.com/haproxytech/client-native/v6/config-parser/types"
+)
+
+// EffectiveString returns the configuration as HAProxy of the given environment sees it, without
+// the branches of conditional blocks evaluated to false, along with the diagnostic directives it emits
+func (p *configParser) EffectiveString(env conditions.Environment) (string, []types.Directive, error) {
+	return conditions.Effective(p.String(), env)
+}
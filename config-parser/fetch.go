@@ -162,6 +162,14 @@ func (p *configParser) SectionsDelete(sectionType Section, sectionName string) e
 	if _, ok := p.Parsers[sectionType]; !ok {
 		return errors.ErrSectionMissing
 	}
+	// sections deleted before and not created again are deleted for good
+	p.settleRemovedSections()
+	if section, ok := p.Parsers[sectionType][sectionName]; ok {
+		// sections are replaced by deleting and creating them again, they must keep their file and directives
+		if removed, ok := newRemovedSection(section); ok {
+			p.removedSections[removedSectionKey(sectionType, sectionName)] = removed
+		}
+	}
 	delete(p.Parsers[sectionType], sectionName)
 	return nil
 }
@@ -189,6 +197,13 @@ func (p *configParser) SectionsCreate(sectionType Section, sectionName string) e
 	parts := []string{string(sectionType), sectionName}
 	comment := ""
 	p.ProcessLine(fmt.Sprintf("%s %s", sectionType, sectionName), parts, comment, parsers)
+	key := removedSectionKey(sectionType, sectionName)
+	if removed, ok := p.removedSections[key]; ok {
+		if section, exists := st[sectionName]; exists {
+			removed.restore(section)
+		}
+		delete(p.removedSections, key)
+	}
 	return nil
 }
 
@@ -0,0 +1,278 @@
+/*
+Copyright 2026 HAProxy Technologies
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parser
+
+import (
+	stderrors "errors"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gofrs/flock"
+	"github.com/google/renameio/maybe"
+
+	"github.com/haproxytech/client-native/v6/config-parser/common"
+	"github.com/haproxytech/client-native/v6/config-parser/errors"
+)
+
+// FileMarker starts the sections of another configuration file when several files are
+// read as one configuration, the path of the file follows it:
+//
+//	# _file=/etc/haproxy/conf.d/team-a.cfg
+//
+// The global section and the comments at the top always belong to the main file.
+const FileMarker = "# _file="
+
+// Files returns the configuration files other than the main one, in load order
+func (p *configParser) Files() []string {
+	p.lock()
+	defer p.unLock()
+	return slices.Clone(p.files)
+}
+
+// SectionFileGet returns the file a section belongs to, "" for the main file
+func (p *configParser) SectionFileGet(sectionType Section, sectionName string) (string, error) {
+	p.lock()
+	defer p.unLock()
+	section, err := p.fileSection(sectionType, sectionName)
+	if err != nil {
+		return "", err
+	}
+	return section.File, nil
+}
+
+// SectionFileSet moves a section to file, "" for the main file. Unknown files are added after the others.
+func (p *configParser) SectionFileSet(sectionType Section, sectionName, file string) error {
+	p.lock()
+	defer p.unLock()
+	section, err := p.fileSection(sectionType, sectionName)
+	if err != nil {
+		return err
+	}
+	p.addFile(file)
+	section.File = file
+	return nil
+}
+
+// FileString returns the content of one configuration file, "" for the main file
+func (p *configParser) FileString(file string) string {
+	p.lock()
+	defer p.unLock()
+	p.settleRemovedSections()
+	var result strings.Builder
+	if file == "" {
+		p.writeParsers("", p.Parsers[Comments][CommentsSectionName], &result, false)
+		p.writeParsers("global", p.Parsers[Global][GlobalSectionName], &result, true)
+		p.writeSections("", &result)
+		return result.String()
+	}
+	p.writeSections(file, &result)
+	return strings.TrimPrefix(result.String(), "\n")
+}
+
+// SaveFiles writes the main configuration file to filename and every other file to its own path,
+// unlike Save which writes the whole configuration, with file markers, to one file. The given files
+// are written too, emptied when no section belongs to them anymore.
+// The files are all written or none is: they are written to temporary files first, renamed
+// once all are written, and the renamed ones are restored if renaming another one fails.
+func (p *configParser) SaveFiles(filename string, files ...string) error {
+	if p.Options.UseMd5Hash {
+		// the hash covers the whole configuration
+		if _, err := p.StringWithHash(); err != nil {
+			return err
+		}
+	}
+	written := []string{filename}
+	data := [][]byte{[]byte(p.FileString(""))}
+	for _, file := range append(p.Files(), files...) {
+		if slices.Contains(written, file) {
+			continue
+		}
+		written = append(written, file)
+		data = append(data, []byte(p.FileString(file)))
+	}
+	files = written
+
+	locks := make([]*flock.Flock, 0, len(files))
+	// files which did not exist, created by their lock
+	var created []string
+	saved := false
+	defer func() {
+		for _, lock := range locks {
+			lock.Unlock() //nolint:errcheck
+		}
+		if !saved {
+			for _, file := range created {
+				_ = os.Remove(file)
+			}
+		}
+	}()
+	for _, file := range files {
+		if _, err := os.Stat(file); stderrors.Is(err, fs.ErrNotExist) {
+			created = append(created, file)
+		}
+		lock := flock.New(file)
+		if err := lock.Lock(); err != nil {
+			return err
+		}
+		locks = append(locks, lock)
+	}
+
+	temporary := make([]string, 0, len(files))
+	defer func() {
+		for _, tmp := range temporary {
+			_ = os.Remove(tmp)
+		}
+	}()
+	for i, file := range files {
+		tmp, err := writeTemporaryFile(file, data[i])
+		if err != nil {
+			return err
+		}
+		temporary = append(temporary, tmp)
+	}
+
+	// content of the files before, to restore them
+	previous := make([][]byte, len(files))
+	for i, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		previous[i] = content
+	}
+	for i, file := range files {
+		if err := os.Rename(temporary[i], file); err != nil {
+			for j := range i {
+				_ = maybe.WriteFile(files[j], previous[j], 0o644)
+			}
+			return err
+		}
+	}
+	saved = true
+	return nil
+}
+
+// writeTemporaryFile writes data to a temporary file next to file and returns its path
+func writeTemporaryFile(file string, data []byte) (string, error) {
+	f, err := os.CreateTemp(filepath.Dir(file), "."+filepath.Base(file)+".*")
+	if err != nil {
+		return "", err
+	}
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(f.Name(), 0o644)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+func (p *configParser) fileSection(sectionType Section, sectionName string) (*Parsers, error) {
+	if sectionType == Comments || sectionType == Global {
+		return nil, errors.ErrSectionTypeNotAllowed
+	}
+	st, ok := p.Parsers[sectionType]
+	if !ok {
+		return nil, errors.ErrSectionMissing
+	}
+	section, ok := st[sectionName]
+	if !ok {
+		return nil, errors.ErrSectionMissing
+	}
+	return section, nil
+}
+
+func (p *configParser) addFile(file string) {
+	if file != "" && !slices.Contains(p.files, file) {
+		p.files = append(p.files, file)
+	}
+}
+
+func removedSectionKey(sectionType Section, sectionName string) string {
+	return string(sectionType) + " " + sectionName
+}
+
+// removedSection is what a deleted section keeps which structured edits don't set again
+type removedSection struct {
+	file           string
+	preDirectives  []string
+	postDirectives []string
+	// data of the conditional blocks and directives inside the section
+	data map[string]common.ParserData
+}
+
+// newRemovedSection returns what must be restored if the section is created again,
+// false when there is nothing to restore
+func newRemovedSection(section *Parsers) (removedSection, bool) {
+	removed := removedSection{
+		file:           section.File,
+		preDirectives:  section.PreDirectives,
+		postDirectives: section.PostDirectives,
+		data:           map[string]common.ParserData{},
+	}
+	for _, name := range []string{".if", ".diag"} {
+		if data, err := section.Get(name); err == nil {
+			removed.data[name] = data
+		}
+	}
+	ok := removed.file != "" || len(removed.preDirectives) > 0 || len(removed.postDirectives) > 0 || len(removed.data) > 0
+	return removed, ok
+}
+
+func (r removedSection) restore(section *Parsers) {
+	section.File = r.file
+	section.PreDirectives = r.preDirectives
+	section.PostDirectives = r.postDirectives
+	for name, data := range r.data {
+		if parser, ok := section.Parsers[name]; ok {
+			_ = parser.Insert(data, -1)
+		}
+	}
+}
+
+// settleRemovedSections ends the replacement of the deleted sections: those not created again are
+// deleted for good, the .if and .endif wrapping only them are dropped, their other directives are kept
+func (p *configParser) settleRemovedSections() {
+	keys := slices.Sorted(maps.Keys(p.removedSections))
+	for _, key := range keys {
+		removed := p.removedSections[key]
+		pre, post := removed.preDirectives, removed.postDirectives
+		if len(pre) > 0 && len(post) > 0 && conditionalKeyword(pre[len(pre)-1]) == ".if" && conditionalKeyword(post[0]) == ".endif" {
+			pre, post = pre[:len(pre)-1], post[1:]
+		}
+		directives := slices.Concat(pre, post)
+		for len(directives) > 0 && strings.TrimSpace(directives[len(directives)-1]) == "" {
+			directives = directives[:len(directives)-1]
+		}
+		if len(directives) > 0 {
+			p.removedDirectives[removed.file] = append(p.removedDirectives[removed.file], directives)
+		}
+	}
+	clear(p.removedSections)
+}
@@ -52,6 +52,8 @@ type ConfiguredParsers struct {
 	SPOEAgent   *Parsers
 	SPOEGroup   *Parsers
 	SPOEMessage *Parsers
+	// LastParser is the parser of the last line of the active section
+	LastParser string
 }
 
 func (p *configParser) Init() {
@@ -71,6 +73,9 @@ func (p *configParser) Init() {
 func (p *configParser) initParserMaps() {
 	p.mutex = &sync.Mutex{}
 	p.lastDefaultsSectionName = ""
+	p.files = nil
+	p.removedSections = map[string]removedSection{}
+	p.removedDirectives = map[string][][]string{}
 
 	p.Parsers = map[Section]map[string]*Parsers{}
 
@@ -44,6 +44,12 @@ type Parsers struct {
 	PreComments        []string
 	PostComments       []string
 	DefaultSectionName string
+	// File the section belongs to, empty for the main configuration file
+	File string
+	// PreDirectives are the conditional and diagnostic lines before the section, as .if blocks wrapping it
+	PreDirectives []string
+	// PostDirectives are the conditional and diagnostic lines after the section
+	PostDirectives []string
 }
 
 func (p *Parsers) Get(attribute string, createIfNotExist ...bool) (common.ParserData, error) {
@@ -24,7 +24,9 @@ import (
 	"sync"
 
 	"github.com/haproxytech/client-native/v6/config-parser/common"
+	"github.com/haproxytech/client-native/v6/config-parser/conditions"
 	"github.com/haproxytech/client-native/v6/config-parser/options"
+	"github.com/haproxytech/client-native/v6/config-parser/types"
 )
 
 type Section string
@@ -69,6 +71,10 @@ type Parser interface {
 	Process(reader io.Reader) error
 	String() string
 	Save(filename string) error
+	SaveFiles(filename string, files ...string) error
+	FileString(file string) string
+	EffectiveString(env conditions.Environment) (string, []types.Directive, error)
+	Files() []string
 	StringWithHash() (string, error)
 	Get(sectionType Section, sectionName string, attribute string, createIfNotExist ...bool) (common.ParserData, error)
 	GetResult(sectionType Section, sectionName string, attribute string) ([]common.ReturnResultLine, error)
@@ -82,6 +88,8 @@ type Parser interface {
 	SectionsCreate(sectionType Section, sectionName string) error
 	SectionsDefaultsFromGet(sectionType Section, sectionName string) (string, error)
 	SectionsDefaultsFromSet(sectionType Section, sectionName, defaultsSection string) error
+	SectionFileGet(sectionType Section, sectionName string) (string, error)
+	SectionFileSet(sectionType Section, sectionName, file string) error
 	Set(sectionType Section, sectionName string, attribute string, data common.ParserData, index ...int) error
 	SetPreComments(sectionType Section, sectionName string, attribute string, preComment []string) error
 	Delete(sectionType Section, sectionName string, attribute string, index ...int) error
@@ -102,6 +110,12 @@ type configParser struct {
 	Options                 options.Parser
 	lastDefaultsSectionName string
 	mutex                   *sync.Mutex
+	// files other than the main one, in load order
+	files []string
+	// file and directives of the deleted sections, restored when they are created again
+	removedSections map[string]removedSection
+	// directives of the deleted sections which were not created again, by file
+	removedDirectives map[string][][]string
 }
 
 func New(opt ...options.ParserOption) (Parser, error) {
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 HAProxy Technologies
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extra
+
+import (
+	"strings"
+
+	"github.com/haproxytech/client-native/v6/config-parser/common"
+	"github.com/haproxytech/client-native/v6/config-parser/errors"
+	"github.com/haproxytech/client-native/v6/config-parser/types"
+)
+
+// ConditionalBlock holds the .if blocks of a section, they span several
+// lines and are read as a whole by the configuration reader
+type ConditionalBlock struct {
+	Name        string
+	data        []types.ConditionalBlock
+	preComments []string // comments that appear before the actual line
+}
+
+func (p *ConditionalBlock) Init() {
+	p.Name = ".if"
+	p.data = []types.ConditionalBlock{}
+}
+
+func (p *ConditionalBlock) Parse(line string, parts []string, comment string) (string, error) {
+	return "", &errors.ParseError{Parser: "ConditionalBlock", Line: line, Message: "conditional blocks span several lines"}
+}
+
+func (p *ConditionalBlock) Result() ([]common.ReturnResultLine, error) {
+	if len(p.data) == 0 {
+		return nil, errors.ErrFetch
+	}
+	result := []common.ReturnResultLine{}
+	for _, block := range p.data {
+		for _, line := range ConditionalBlockLines(block) {
+			result = append(result, common.ReturnResultLine{Data: line})
+		}
+	}
+	return result, nil
+}
+
+// ConditionalBlockLines returns the lines of a conditional block, as written in its section
+func ConditionalBlockLines(block types.ConditionalBlock) []string {
+	result := []string{}
+	for _, branch := range block.Branches {
+		data := branch.Keyword
+		if branch.Condition != "" {
+			data += " " + branch.Condition
+		}
+		result = append(result, data)
+		// lines are indented one level more than their block
+		depth := 1
+		for _, line := range branch.Lines {
+			keyword, _, _ := strings.Cut(line, " ")
+			switch keyword {
+			case ".elif", ".else", ".endif":
+				depth--
+			}
+			result = append(result, strings.Repeat("  ", depth)+line)
+			switch keyword {
+			case ".if", ".elif", ".else":
+				depth++
+			}
+		}
+	}
+	return append(result, ".endif")
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 HAProxy Technologies
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extra
+
+import (
+	"strings"
+
+	"github.com/haproxytech/client-native/v6/config-parser/common"
+	"github.com/haproxytech/client-native/v6/config-parser/errors"
+	"github.com/haproxytech/client-native/v6/config-parser/types"
+)
+
+// Directive holds the .diag, .notice, .warning and .alert directives of a section
+type Directive struct {
+	Name        string
+	data        []types.Directive
+	preComments []string // comments that appear before the actual line
+}
+
+func (p *Directive) Init() {
+	p.Name = ".diag"
+	p.data = []types.Directive{}
+}
+
+func (p *Directive) Parse(line string, parts []string, comment string) (string, error) {
+	if len(parts) == 0 {
+		return "", &errors.ParseError{Parser: "Directive", Line: line}
+	}
+	switch parts[0] {
+	case ".diag", ".notice", ".warning", ".alert":
+	default:
+		return "", &errors.ParseError{Parser: "Directive", Line: line}
+	}
+	p.data = append(p.data, types.Directive{
+		Keyword: parts[0],
+		Message: strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), parts[0])),
+	})
+	return "", nil
+}
+
+func (p *Directive) Result() ([]common.ReturnResultLine, error) {
+	if len(p.data) == 0 {
+		return nil, errors.ErrFetch
+	}
+	result := make([]common.ReturnResultLine, len(p.data))
+	for index, d := range p.data {
+		data := d.Keyword
+		if d.Message != "" {
+			data += " " + d.Message
+		}
+		result[index] = common.ReturnResultLine{Data: data}
+	}
+	return result, nil
+}
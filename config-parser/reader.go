@@ -19,12 +19,15 @@ package parser
 import (
 	"bufio"
 	"bytes"
+	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/haproxytech/client-native/v6/config-parser/common"
+	"github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/parsers/extra"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
 )
@@ -54,22 +57,128 @@ func (p *configParser) Process(reader io.Reader) error {
 
 	bufferedScanner := bufio.NewScanner(reader)
 
-	var line string
+	// file of the sections being read, empty for the main configuration file
+	var file string
+	// conditional block being read and its depth
+	var block []string
+	depth := 0
+	// top level conditional and diagnostic lines, waiting for the section they precede
+	var pending []string
+	// first error met, the data being read to the end
+	var processErr error
 
-	if p.Options.Log {
-		p.Options.Logger.Debugf("%sprocessing of data started", p.Options.LogPrefix)
+	// flush attaches the pending lines to the end of the active section
+	flush := func() {
+		if depth > 0 {
+			// unterminated block, kept as is
+			pending = append(pending, block...)
+			block = nil
+			depth = 0
+		}
+		if pending != nil {
+			if pending[len(pending)-1] == "" {
+				pending = pending[:len(pending)-1]
+			}
+			if parsers.Active != parsers.Comments {
+				pending = append([]string{""}, pending...)
+			}
+			parsers.Active.PostDirectives = append(parsers.Active.PostDirectives, pending...)
+			pending = nil
+		}
 	}
-	for bufferedScanner.Scan() {
-		line = bufferedScanner.Text()
-		// ScanLines strips only one trailing '\r'; drop the rest so they don't
-		// leak into comment/value text and bleed out one per save cycle.
-		line = strings.TrimRight(line, "\r")
+
+	var processLine func(line string)
+	processLine = func(line string) {
+		keyword := conditionalKeyword(line)
+		if depth > 0 {
+			block = append(block, line)
+			switch keyword {
+			case ".if":
+				depth++
+			case ".endif":
+				depth--
+			}
+			if depth > 0 {
+				return
+			}
+			lines := block
+			block = nil
+			switch {
+			case wrapsSections(lines):
+				// the sections of the block are read as any other, each one wrapped by the condition of
+				// its branch as sections are not written in the order they are read
+				conditions, bodies := sectionBranches(lines)
+				endif := lines[len(lines)-1]
+				open := false
+				for i, body := range bodies {
+					for _, l := range body {
+						if depth == 0 && isSectionHeader(l) {
+							if open {
+								parsers.Active.PostDirectives = append(parsers.Active.PostDirectives, endif)
+							}
+							pending = append(pending, conditions[i])
+							open = true
+						}
+						processLine(l)
+					}
+				}
+				if open {
+					parsers.Active.PostDirectives = append(parsers.Active.PostDirectives, endif)
+				}
+			case hasSection(lines):
+				// blocks of several sections are kept as is, apart from the sections around them
+				pending = append(pending, lines...)
+				pending = append(pending, "")
+			case parsers.Active == parsers.Comments:
+				pending = append(pending, lines...)
+			default:
+				if parser, ok := parsers.Active.Parsers[".if"]; ok {
+					// the block is kept after the line preceding it
+					conditional := parseConditionalBlock(lines)
+					if last, ok := parsers.Active.Parsers[parsers.LastParser]; ok {
+						conditional.After = parsers.LastParser
+						results, _, _ := last.ResultAll()
+						conditional.AfterIndex = len(results)
+					}
+					_ = parser.Insert(conditional, -1)
+				} else {
+					pending = append(pending, lines...)
+				}
+			}
+			return
+		}
+		if parsers.State != "snippet_beg" {
+			switch keyword {
+			case ".if":
+				block = []string{line}
+				depth = 1
+				return
+			case ".diag", ".notice", ".warning", ".alert":
+				if parsers.Active == parsers.Comments {
+					pending = append(pending, line)
+					return
+				}
+				if parser, ok := parsers.Active.Parsers[".diag"]; ok {
+					parts := common.StringSplitIgnoreEmpty(line, ' ')
+					if _, err := parser.Parse(line, parts, ""); err == nil {
+						parsers.LastParser = parser.GetParserName()
+						return
+					}
+				}
+			}
+		}
 
 		if line == "" {
 			if parsers.State == "" {
 				parsers.State = "#"
 			}
-			continue
+			return
+		}
+		if f, ok := strings.CutPrefix(line, FileMarker); ok {
+			flush()
+			file = strings.TrimSpace(f)
+			p.addFile(file)
+			return
 		}
 		parts, comment := common.StringSplitWithCommentIgnoreEmpty(line)
 		if len(parts) == 0 && comment != "" {
@@ -85,13 +194,39 @@ func (p *configParser) Process(reader io.Reader) error {
 			}
 		}
 		if len(parts) == 0 {
-			continue
+			return
 		}
 		if p.Options.Log {
 			p.Options.Logger.Tracef("%sprocessing line: %s", p.Options.LogPrefix, line)
 		}
+		if len(parts) > 1 && processErr == nil && isSectionHeader(line) {
+			// sections of the same name, as in the branches of a conditional block, can't be told apart
+			if _, exists := p.Parsers[Section(parts[0])][parts[1]]; exists {
+				processErr = fmt.Errorf("%w: %s %s", errors.ErrSectionAlreadyExists, parts[0], parts[1])
+			}
+		}
+		active := parsers.Active
 		parsers = p.ProcessLine(line, parts, comment, parsers)
+		if parsers.Active != active && parsers.Active != parsers.Comments {
+			if file != "" && parsers.Active != parsers.Global {
+				parsers.Active.File = file
+			}
+			if pending != nil {
+				parsers.Active.PreDirectives = append(parsers.Active.PreDirectives, pending...)
+				pending = nil
+			}
+		}
+	}
+
+	if p.Options.Log {
+		p.Options.Logger.Debugf("%sprocessing of data started", p.Options.LogPrefix)
 	}
+	for bufferedScanner.Scan() {
+		// ScanLines strips only one trailing '\r'; drop the rest so they don't
+		// leak into comment/value text and bleed out one per save cycle.
+		processLine(strings.TrimRight(bufferedScanner.Text(), "\r"))
+	}
+	flush()
 	if parsers.ActiveComments != nil {
 		parsers.Active.PostComments = parsers.ActiveComments
 	}
@@ -101,7 +236,141 @@ func (p *configParser) Process(reader io.Reader) error {
 	if p.Options.Log {
 		p.Options.Logger.Debugf("%sprocessing of data ended", p.Options.LogPrefix)
 	}
-	return nil
+	return processErr
+}
+
+// conditionalKeyword returns the keyword of conditional and diagnostic lines, empty for other lines
+func conditionalKeyword(line string) string {
+	keyword, _, _ := strings.Cut(strings.TrimLeft(line, " \t"), " ")
+	keyword = strings.TrimRight(keyword, "\t")
+	if !strings.HasPrefix(keyword, ".") {
+		return ""
+	}
+	return keyword
+}
+
+// sectionKeywords are the keywords starting a section
+var sectionKeywords = map[string]struct{}{
+	string(Defaults): {}, string(Global): {}, string(Resolvers): {}, string(UserList): {}, string(Peers): {},
+	string(Mailers): {}, string(Frontends): {}, string(Backends): {}, string(Listen): {}, string(Cache): {},
+	string(HTTPErrors): {}, string(HealthChecks): {}, string(Ring): {}, string(LogForward): {}, string(FCGIApp): {},
+	string(CrtStore): {}, string(Traces): {}, string(LogProfile): {}, string(Acme): {},
+}
+
+func isSectionHeader(line string) bool {
+	parts, _ := common.StringSplitWithCommentIgnoreEmpty(line)
+	if len(parts) == 0 {
+		return false
+	}
+	_, ok := sectionKeywords[parts[0]]
+	return ok
+}
+
+func hasSection(lines []string) bool {
+	for _, line := range lines {
+		if isSectionHeader(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// wrapsSections returns true if a conditional block only wraps sections: each of its branches starts
+// with a section and there are no sections in the blocks it holds
+func wrapsSections(lines []string) bool {
+	if len(lines) < 3 {
+		return false
+	}
+	_, bodies := sectionBranches(lines)
+	for _, body := range bodies {
+		first := slices.IndexFunc(body, func(line string) bool { return strings.TrimSpace(line) != "" })
+		if first < 0 || !isSectionHeader(body[first]) {
+			return false
+		}
+		depth := 0
+		for _, line := range body {
+			switch conditionalKeyword(line) {
+			case ".if":
+				depth++
+			case ".endif":
+				depth--
+			}
+			if depth > 0 && isSectionHeader(line) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// sectionBranches returns the branches of a conditional block, with the .if line holding the
+// condition of each branch: the condition of a branch is true when those of the previous ones are not
+func sectionBranches(lines []string) ([]string, [][]string) {
+	indent := lines[0][:len(lines[0])-len(strings.TrimLeft(lines[0], " \t"))]
+	conditions := []string{lines[0]}
+	previous := []string{"!(" + conditionText(lines[0]) + ")"}
+	bodies := [][]string{nil}
+	depth := 0
+	for _, line := range lines[1 : len(lines)-1] {
+		keyword := conditionalKeyword(line)
+		if depth == 0 && (keyword == ".elif" || keyword == ".else") {
+			condition := strings.Join(previous, " && ")
+			if keyword == ".elif" {
+				condition += " && (" + conditionText(line) + ")"
+				previous = append(previous, "!("+conditionText(line)+")")
+			}
+			conditions = append(conditions, indent+".if "+condition)
+			bodies = append(bodies, nil)
+			continue
+		}
+		switch keyword {
+		case ".if":
+			depth++
+		case ".endif":
+			depth--
+		}
+		bodies[len(bodies)-1] = append(bodies[len(bodies)-1], line)
+	}
+	return conditions, bodies
+}
+
+// conditionText returns the condition of an .if or .elif line, without its comment
+func conditionText(line string) string {
+	parts, _ := common.StringSplitWithCommentIgnoreEmpty(line)
+	if len(parts) < 2 {
+		return "0"
+	}
+	return strings.Join(parts[1:], " ")
+}
+
+// parseConditionalBlock returns the branches of a conditional block inside a section
+func parseConditionalBlock(lines []string) types.ConditionalBlock {
+	result := types.ConditionalBlock{}
+	depth := 0
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		keyword := conditionalKeyword(line)
+		if (depth == 0 && keyword == ".if") || (depth == 1 && (keyword == ".elif" || keyword == ".else")) {
+			result.Branches = append(result.Branches, types.ConditionalBranch{
+				Keyword:   keyword,
+				Condition: strings.TrimSpace(strings.TrimPrefix(line, keyword)),
+			})
+			depth = 1
+			continue
+		}
+		switch keyword {
+		case ".if":
+			depth++
+		case ".endif":
+			depth--
+		}
+		if depth == 0 || line == "" {
+			continue
+		}
+		branch := &result.Branches[len(result.Branches)-1]
+		branch.Lines = append(branch.Lines, line)
+	}
+	return result
 }
 
 // ProcessLine parses line plus determines if we need to change state
@@ -430,6 +699,9 @@ func (p *configParser) ProcessLine(line string, parts []string, comment string,
 					config.Active.PreComments = config.ActiveSectionComments
 					config.ActiveSectionComments = nil
 				}
+				config.LastParser = ""
+			} else {
+				config.LastParser = parser.GetParserName()
 			}
 			config.ActiveComments = nil
 			break
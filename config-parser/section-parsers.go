@@ -53,6 +53,8 @@ func (p *configParser) createParsers(parser map[string]ParserInterface, sequence
 	addParser(parser, &sequence, &extra.Section{Name: "log-profile"})
 	addParser(parser, &sequence, &extra.Section{Name: "acme"})
 	addParser(parser, &sequence, &extra.Section{Name: "healthcheck"})
+	addParser(parser, &sequence, &extra.Directive{})
+	addParser(parser, &sequence, &extra.ConditionalBlock{})
 	if !p.Options.DisableUnProcessed {
 		addParser(parser, &sequence, &extra.UnProcessed{})
 	}
@@ -0,0 +1,300 @@
+/*
+Copyright 2026 HAProxy Technologies
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package configs //nolint:testpackage
+
+import (
+	stderrors "errors"
+	"slices"
+	"strings"
+	"testing"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+	"github.com/haproxytech/client-native/v6/config-parser/conditions"
+	"github.com/haproxytech/client-native/v6/config-parser/errors"
+	"github.com/haproxytech/client-native/v6/config-parser/options"
+	"github.com/haproxytech/client-native/v6/config-parser/types"
+)
+
+const configConditionals = `# _version=1
+
+.notice "loading"
+global
+  daemon
+  .if feature(QUIC)
+    limited-quic
+  .endif
+
+defaults A
+  mode http
+
+backend be_app from A
+  mode http
+  .warning "check me"
+  .if streq("$ENV",prod)
+    balance roundrobin
+  .elif streq("$ENV",staging)
+    .if defined(SLOW)
+      balance first
+    .endif
+  .else
+    balance leastconn
+  .endif
+
+.if version_atleast(3.0)
+frontend f1 from A
+  mode http
+.else
+frontend f2 from A
+  mode tcp
+.endif
+
+.if defined(FOO)
+backend be_foo from A
+  balance roundrobin
+.endif
+`
+
+// configConditionalsWritten is configConditionals as written: each section of a block gets its own
+const configConditionalsWritten = `# _version=1
+
+.notice "loading"
+global
+  daemon
+  .if feature(QUIC)
+    limited-quic
+  .endif
+
+defaults A
+  mode http
+
+.if version_atleast(3.0)
+frontend f1 from A
+  mode http
+.endif
+
+.if !(version_atleast(3.0))
+frontend f2 from A
+  mode tcp
+.endif
+
+backend be_app from A
+  mode http
+  .warning "check me"
+  .if streq("$ENV",prod)
+    balance roundrobin
+  .elif streq("$ENV",staging)
+    .if defined(SLOW)
+      balance first
+    .endif
+  .else
+    balance leastconn
+  .endif
+
+.if defined(FOO)
+backend be_foo from A
+  balance roundrobin
+.endif
+`
+
+const configConditionalsEffective = `# _version=1
+
+global
+  daemon
+
+defaults A
+  mode http
+
+
+frontend f2 from A
+  mode tcp
+
+backend be_app from A
+  mode http
+      balance first
+
+`
+
+func TestConditionals(t *testing.T) {
+	p, err := parser.New(options.String(configConditionals))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := p.String(); result != configConditionalsWritten {
+		compare(t, configConditionalsWritten, result)
+		t.Fatalf("configurations does not match")
+	}
+
+	// sections wrapped by a block are read as any other
+	if !p.SectionExists(parser.Backends, "be_foo") {
+		t.Errorf("backend be_foo is missing")
+	}
+	data, err := p.Get(parser.Backends, "be_app", ".if")
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocks, ok := data.([]types.ConditionalBlock)
+	if !ok || len(blocks) != 1 || len(blocks[0].Branches) != 3 {
+		t.Fatalf("conditional blocks of be_app = %v, want one block with three branches", data)
+	}
+	if branch := blocks[0].Branches[1]; branch.Keyword != ".elif" || branch.Condition != `streq("$ENV",staging)` || len(branch.Lines) != 3 {
+		t.Errorf("second branch = %+v, want .elif with the nested block", branch)
+	}
+
+	// structured edits replace sections, their directives stay
+	if err = p.SectionsDelete(parser.Backends, "be_foo"); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.SectionsCreate(parser.Backends, "be_foo"); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.Insert(parser.Backends, "be_foo", "balance", &types.Balance{Algorithm: "roundrobin"}); err != nil {
+		t.Fatal(err)
+	}
+	if result := p.String(); result != configConditionalsWritten {
+		compare(t, configConditionalsWritten, result)
+		t.Fatalf("configurations does not match after replacing be_foo")
+	}
+
+	// deleted for good, the block wrapping only be_foo goes with it
+	if err = p.SectionsDelete(parser.Backends, "be_foo"); err != nil {
+		t.Fatal(err)
+	}
+	deleted := strings.Replace(configConditionalsWritten, ".if defined(FOO)\nbackend be_foo from A\n  balance roundrobin\n.endif\n", "", 1)
+	deleted = strings.TrimSuffix(deleted, "\n")
+	if result := p.String(); result != deleted {
+		compare(t, deleted, result)
+		t.Fatalf("configurations does not match after deleting be_foo")
+	}
+	// a new section of the same name is not wrapped
+	if err = p.SectionsCreate(parser.Backends, "be_foo"); err != nil {
+		t.Fatal(err)
+	}
+	if result := p.String(); !strings.Contains(result, "\n\nbackend be_foo from A\n") || strings.Contains(result, "defined(FOO)") {
+		t.Fatalf("new be_foo is wrapped:\n%s", result)
+	}
+
+	if p, err = parser.New(options.String(configConditionals)); err != nil {
+		t.Fatal(err)
+	}
+	env := conditions.Environment{
+		Version: "2.8.5",
+		Env:     map[string]string{"ENV": "staging", "SLOW": ""},
+	}
+	effective, directives, err := p.EffectiveString(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if effective != configConditionalsEffective {
+		compare(t, configConditionalsEffective, effective)
+		t.Errorf("effective configuration does not match")
+	}
+	if len(directives) != 2 || directives[0] != (types.Directive{Keyword: ".notice", Message: "loading"}) || directives[1].Keyword != ".warning" {
+		t.Errorf("directives = %v, want .notice and .warning", directives)
+	}
+}
+
+const configConditionalRules = `# _version=1
+
+frontend f
+  mode http
+  http-request deny if { path /admin }
+  .if version_atleast(2.8)
+    http-request set-header X-Version new
+  .endif
+  http-request allow
+  default_backend be
+`
+
+func TestConditionalsPosition(t *testing.T) {
+	p, err := parser.New(options.String(configConditionalRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := p.String(); result != configConditionalRules {
+		compare(t, configConditionalRules, result)
+		t.Fatalf("configurations does not match")
+	}
+
+	// edits of other lines keep the block in place
+	if err = p.Set(parser.Frontends, "f", "default_backend", &types.StringC{Value: "be2"}); err != nil {
+		t.Fatal(err)
+	}
+	expected := strings.Replace(configConditionalRules, "default_backend be\n", "default_backend be2\n", 1)
+	if result := p.String(); result != expected {
+		compare(t, expected, result)
+		t.Fatalf("configurations does not match after changing default_backend")
+	}
+}
+
+const configConditionalSections = `# _version=1
+
+backend other
+  mode http
+
+.if feature(QUIC)
+frontend fq
+  mode http
+
+backend bq
+  mode http
+.endif
+`
+
+func TestConditionalSections(t *testing.T) {
+	p, err := parser.New(options.String(configConditionalSections))
+	if err != nil {
+		t.Fatal(err)
+	}
+	backends, err := p.SectionsGet(parser.Backends)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frontends, err := p.SectionsGet(parser.Frontends)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(backends)
+	if !slices.Equal(backends, []string{"bq", "other"}) || !slices.Equal(frontends, []string{"fq"}) {
+		t.Fatalf("backends %v and frontends %v, want bq, other and fq", backends, frontends)
+	}
+	if err = p.SectionsCreate(parser.Backends, "bq"); !stderrors.Is(err, errors.ErrSectionAlreadyExists) {
+		t.Errorf("SectionsCreate() of a wrapped section error = %v, want ErrSectionAlreadyExists", err)
+	}
+
+	// each section keeps the condition, whatever the order they are written in
+	written := p.String()
+	for _, section := range []string{"frontend fq", "backend bq"} {
+		if !strings.Contains(written, ".if feature(QUIC)\n"+section+"\n  mode http\n.endif\n") {
+			t.Errorf("%s not wrapped:\n%s", section, written)
+		}
+	}
+	if strings.Contains(written, ".if feature(QUIC)\nbackend other") {
+		t.Errorf("backend other wrapped:\n%s", written)
+	}
+	if p, err = parser.New(options.String(written)); err != nil {
+		t.Fatal(err)
+	}
+	if result := p.String(); result != written {
+		compare(t, written, result)
+		t.Errorf("written configuration does not read back the same")
+	}
+
+	// sections of the same name in the branches of a block can't be told apart
+	duplicate := ".if feature(QUIC)\nfrontend f1\n  mode http\n.else\nfrontend f1\n  mode tcp\n.endif\n"
+	if _, err = parser.New(options.String(duplicate)); !stderrors.Is(err, errors.ErrSectionAlreadyExists) {
+		t.Errorf("reading sections of the same name error = %v, want ErrSectionAlreadyExists", err)
+	}
+}
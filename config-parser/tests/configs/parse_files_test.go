@@ -0,0 +1,156 @@
+/*
+Copyright 2026 HAProxy Technologies
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package configs //nolint:testpackage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
+	"github.com/haproxytech/client-native/v6/config-parser/options"
+)
+
+const configFiles = `# _version=1
+
+global
+  daemon
+
+defaults A
+  mode http
+
+backend main from A
+  mode http
+
+# _file=conf.d/a.cfg
+
+frontend fe_a from A
+  mode http
+
+backend be_a from A
+  mode http
+
+# _file=conf.d/b.cfg
+
+backend be_b from A
+  mode tcp
+`
+
+func TestFiles(t *testing.T) {
+	p, err := parser.New(options.String(configFiles))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := p.String(); result != configFiles {
+		compare(t, configFiles, result)
+		t.Fatalf("configurations does not match")
+	}
+	files := p.Files()
+	if len(files) != 2 || files[0] != "conf.d/a.cfg" || files[1] != "conf.d/b.cfg" {
+		t.Fatalf("files = %v, want [conf.d/a.cfg conf.d/b.cfg]", files)
+	}
+	for section, want := range map[string]string{"main": "", "be_a": "conf.d/a.cfg", "be_b": "conf.d/b.cfg"} {
+		file, err := p.SectionFileGet(parser.Backends, section)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if file != want {
+			t.Errorf("file of backend %s = %q, want %q", section, file, want)
+		}
+	}
+
+	wantA := "frontend fe_a from A\n  mode http\n\nbackend be_a from A\n  mode http\n"
+	if result := p.FileString("conf.d/a.cfg"); result != wantA {
+		compare(t, wantA, result)
+		t.Errorf("conf.d/a.cfg does not match")
+	}
+	wantMain := "# _version=1\n\nglobal\n  daemon\n\ndefaults A\n  mode http\n\nbackend main from A\n  mode http\n"
+	if result := p.FileString(""); result != wantMain {
+		compare(t, wantMain, result)
+		t.Errorf("main file does not match")
+	}
+
+	if err = p.SectionsCreate(parser.Backends, "be_new"); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.SectionFileSet(parser.Backends, "be_new", "conf.d/c.cfg"); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.SectionFileSet(parser.Backends, "be_b", ""); err != nil {
+		t.Fatal(err)
+	}
+	if result := p.FileString("conf.d/c.cfg"); result != "backend be_new from A\n" {
+		t.Errorf("conf.d/c.cfg = %q, want backend be_new", result)
+	}
+	if result := p.FileString("conf.d/b.cfg"); result != "" {
+		t.Errorf("conf.d/b.cfg = %q, want empty", result)
+	}
+	if files = p.Files(); len(files) != 3 || files[2] != "conf.d/c.cfg" {
+		t.Errorf("files = %v, want conf.d/c.cfg added", files)
+	}
+
+	if err = p.SectionFileSet(parser.Global, parser.GlobalSectionName, "conf.d/a.cfg"); !errors.Is(err, parser_errors.ErrSectionTypeNotAllowed) {
+		t.Errorf("SectionFileSet() of global error = %v, want ErrSectionTypeNotAllowed", err)
+	}
+	if _, err = p.SectionFileGet(parser.Backends, "missing"); !errors.Is(err, parser_errors.ErrSectionMissing) {
+		t.Errorf("SectionFileGet() of a missing section error = %v, want ErrSectionMissing", err)
+	}
+}
+
+func TestSaveFiles(t *testing.T) {
+	dir := t.TempDir()
+	main := filepath.Join(dir, "haproxy.cfg")
+	config := strings.ReplaceAll(configFiles, "# _file=conf.d/", "# _file="+filepath.Join(dir, "conf.d")+"/")
+	p, err := parser.New(options.String(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(main, []byte("# _version=0\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// b.cfg can't be written, nothing is
+	if err = os.MkdirAll(filepath.Join(dir, "conf.d", "b.cfg"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.SaveFiles(main); err == nil {
+		t.Fatal("SaveFiles() succeeded, want an error")
+	}
+	if data, _ := os.ReadFile(main); string(data) != "# _version=0\n" {
+		t.Errorf("main file = %q, want it unchanged", data)
+	}
+	if _, err = os.Stat(filepath.Join(dir, "conf.d", "a.cfg")); err == nil {
+		t.Errorf("conf.d/a.cfg written")
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 2 {
+		t.Errorf("temporary files left in %s: %v", dir, entries)
+	}
+
+	if err = os.Remove(filepath.Join(dir, "conf.d", "b.cfg")); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.SaveFiles(main); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "conf.d", "b.cfg")); string(data) != "backend be_b from A\n  mode tcp\n" {
+		t.Errorf("conf.d/b.cfg = %q", data)
+	}
+	if data, _ := os.ReadFile(main); !strings.HasPrefix(string(data), "# _version=1\n") {
+		t.Errorf("main file = %q", data)
+	}
+}
@@ -67,6 +67,42 @@ type UnProcessed struct {
 	Value string
 }
 
+//name:conditional-block
+//no:sections
+//dir:extra
+//is:multiple
+//no:init
+//no:parse
+//test:skip
+type ConditionalBlock struct {
+	Branches []ConditionalBranch
+	// After is the parser of the line preceding the block in its section, empty at the start of the section
+	After string
+	// AfterIndex is the number of lines of the After parser preceding the block
+	AfterIndex int
+}
+
+// ConditionalBranch is the .if, .elif or .else branch of a conditional block.
+// Lines are kept as written, nested conditional blocks included.
+type ConditionalBranch struct {
+	Keyword   string
+	Condition string
+	Lines     []string
+}
+
+//name:directive
+//no:sections
+//dir:extra
+//is:multiple
+//no:init
+//no:parse
+//test:skip
+type Directive struct {
+	// Keyword is one of .diag, .notice, .warning or .alert
+	Keyword string
+	Message string
+}
+
 //name:simple-option
 //no:sections
 //struct:name:Option
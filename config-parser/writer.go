@@ -22,10 +22,12 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 
 	"github.com/gofrs/flock"
 	"github.com/google/renameio/maybe"
+	"github.com/haproxytech/client-native/v6/config-parser/parsers/extra"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
 )
 
@@ -36,11 +38,26 @@ func (p *configParser) String() string {
 	}
 	p.lock()
 	defer p.unLock()
+	p.settleRemovedSections()
 	var result strings.Builder
 
 	p.writeParsers("", p.Parsers[Comments][CommentsSectionName], &result, false)
 	p.writeParsers("global", p.Parsers[Global][GlobalSectionName], &result, true)
+	p.writeSections("", &result)
 
+	// sections of the other files follow, each file introduced by its marker
+	for _, file := range p.files {
+		_, _ = result.WriteString("\n")
+		_, _ = result.WriteString(FileMarker)
+		_, _ = result.WriteString(file)
+		_, _ = result.WriteString("\n")
+		p.writeSections(file, &result)
+	}
+	return result.String()
+}
+
+// writeSections writes the sections read from or moved to file, "" being the main configuration file
+func (p *configParser) writeSections(file string, result io.StringWriter) {
 	sections := []Section{
 		Defaults,
 		UserList,
@@ -75,6 +92,9 @@ func (p *configParser) String() string {
 		}
 
 		for _, sectionName := range sortedSections {
+			if p.Parsers[section][sectionName].File != file {
+				continue
+			}
 			var sName string
 			if sectionName != "" {
 				sName = fmt.Sprintf("%s %s", section, sectionName)
@@ -83,11 +103,19 @@ func (p *configParser) String() string {
 			}
 			serializeOk := p.shouldSerialize(section, sectionName)
 			if serializeOk {
-				p.writeParsers(sName, p.Parsers[section][sectionName], &result, true)
+				p.writeParsers(sName, p.Parsers[section][sectionName], result, true)
 			}
 		}
 	}
-	return result.String()
+
+	// directives around deleted sections are kept, they might open or close blocks of other sections
+	for _, directives := range p.removedDirectives[file] {
+		_, _ = result.WriteString("\n")
+		for _, line := range directives {
+			_, _ = result.WriteString(line)
+			_, _ = result.WriteString("\n")
+		}
+	}
 }
 
 func (p *configParser) shouldSerialize(section Section, sectionName string) bool {
@@ -149,8 +177,12 @@ func (p *configParser) StringWithHash() (string, error) {
 	return result.String(), nil
 }
 
-func (p *configParser) writeSection(sectionName string, comment string, preComments []string, defaultsSection string, result io.StringWriter) {
+func (p *configParser) writeSection(sectionName string, comment string, preDirectives, preComments []string, defaultsSection string, result io.StringWriter) {
 	_, _ = result.WriteString("\n")
+	for _, line := range preDirectives {
+		_, _ = result.WriteString(line)
+		_, _ = result.WriteString("\n")
+	}
 	for _, line := range preComments {
 		_, _ = result.WriteString("# ")
 		_, _ = result.WriteString(line)
@@ -176,40 +208,75 @@ func (p *configParser) writeParsers(sectionName string, parsersData *Parsers, re
 	case "":
 		sectionNameWritten = true
 	case "global":
-		break
+		// the section is written only when it has content, or when directives precede it
+		if len(parsersData.PreDirectives) > 0 {
+			p.writeSection(sectionName, parsersData.Section.Comment, parsersData.PreDirectives, parsersData.PreComments, parsersData.DefaultSectionName, result)
+			sectionNameWritten = true
+		}
 	default:
-		p.writeSection(sectionName, parsersData.Section.Comment, parsersData.PreComments, parsersData.DefaultSectionName, result)
+		p.writeSection(sectionName, parsersData.Section.Comment, parsersData.PreDirectives, parsersData.PreComments, parsersData.DefaultSectionName, result)
 		sectionNameWritten = true
 	}
+	writeLine := func(line string) {
+		if useIndentation {
+			_, _ = result.WriteString("  ")
+		}
+		_, _ = result.WriteString(line)
+		_, _ = result.WriteString("\n")
+	}
+	// conditional blocks are written after the line they followed
+	var blocks []types.ConditionalBlock
+	if parser, ok := parsersData.Parsers[".if"]; ok {
+		if data, err := parser.Get(false); err == nil {
+			blocks = slices.Clone(data.([]types.ConditionalBlock)) //nolint:forcetypeassert
+		}
+	}
+	writeBlocks := func(after string, index int) {
+		blocks = slices.DeleteFunc(blocks, func(block types.ConditionalBlock) bool {
+			if block.After != after || (index >= 0 && block.AfterIndex != index) {
+				return false
+			}
+			if !sectionNameWritten {
+				p.writeSection(sectionName, parsersData.Section.Comment, parsersData.PreDirectives, parsersData.PreComments, parsersData.DefaultSectionName, result)
+				sectionNameWritten = true
+			}
+			for _, line := range extra.ConditionalBlockLines(block) {
+				writeLine(line)
+			}
+			return true
+		})
+	}
+	writeBlocks("", -1)
 	for _, parserName := range parsersData.ParserSequence {
+		if parserName == ".if" {
+			continue
+		}
 		parser := parsersData.Parsers[string(parserName)]
 		lines, comments, err := parser.ResultAll()
 		if err != nil {
 			continue
 		}
 		if !sectionNameWritten {
-			p.writeSection(sectionName, parsersData.Section.Comment, parsersData.PreComments, parsersData.DefaultSectionName, result)
+			p.writeSection(sectionName, parsersData.Section.Comment, parsersData.PreDirectives, parsersData.PreComments, parsersData.DefaultSectionName, result)
 			sectionNameWritten = true
 		}
 		for _, line := range comments {
-			if useIndentation {
-				_, _ = result.WriteString("  ")
-			}
-			_, _ = result.WriteString("# ")
-			_, _ = result.WriteString(line)
-			_, _ = result.WriteString("\n")
+			writeLine("# " + line)
 		}
-		for _, line := range lines {
-			if useIndentation {
-				_, _ = result.WriteString("  ")
-			}
-			_, _ = result.WriteString(line.Data)
+		for i, line := range lines {
 			if line.Comment != "" {
-				_, _ = result.WriteString(" # ")
-				_, _ = result.WriteString(line.Comment)
+				writeLine(line.Data + " # " + line.Comment)
+			} else {
+				writeLine(line.Data)
 			}
-			_, _ = result.WriteString("\n")
+			writeBlocks(string(parserName), i+1)
 		}
+		// blocks after lines since deleted
+		writeBlocks(string(parserName), -1)
+	}
+	// blocks after parsers without lines anymore
+	for len(blocks) > 0 {
+		writeBlocks(blocks[0].After, -1)
 	}
 	for _, line := range parsersData.PostComments {
 		if useIndentation {
@@ -219,4 +286,8 @@ func (p *configParser) writeParsers(sectionName string, parsersData *Parsers, re
 		_, _ = result.WriteString(line)
 		_, _ = result.WriteString("\n")
 	}
+	for _, line := range parsersData.PostDirectives {
+		_, _ = result.WriteString(line)
+		_, _ = result.WriteString("\n")
+	}
 }
@@ -19,7 +19,6 @@ import (
 	"errors"
 	"strconv"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 
@@ -113,7 +112,7 @@ func (c *client) DeleteACL(id int64, parentType string, parentName string, trans
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateACL(id int64, parentType string, parentName string, data *models.ACL, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -140,7 +139,7 @@ func (c *client) CreateACL(id int64, parentType string, parentName string, data
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditACL(id int64, parentType string, parentName string, data *models.ACL, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -171,7 +170,7 @@ func (c *client) EditACL(id int64, parentType string, parentName string, data *m
 // Returns error on fail, nil on success.
 func (c *client) ReplaceAcls(parentType string, parentName string, data models.Acls, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
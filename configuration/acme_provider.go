@@ -22,7 +22,7 @@ import (
 	"sort"
 	"strings"
 
-	strfmt "github.com/go-openapi/strfmt"
+	"github.com/haproxytech/client-native/v6/capabilities"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -96,8 +96,12 @@ func (c *client) DeleteAcmeProvider(name, transactionID string, version int64) e
 }
 
 func (c *client) CreateAcmeProvider(data *models.AcmeProvider, transactionID string, version int64) error {
+	if err := c.CheckCapability(capabilities.Keyword, capabilities.KeywordAcme); err != nil {
+		return err
+	}
+
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -126,7 +130,7 @@ func (c *client) CreateAcmeProvider(data *models.AcmeProvider, transactionID str
 
 func (c *client) EditAcmeProvider(name string, data *models.AcmeProvider, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
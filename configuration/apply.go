@@ -0,0 +1,243 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"fmt"
+	"slices"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+type Declarative interface {
+	// GetStructuredConfiguration returns the whole configuration as structured sections.
+	GetStructuredConfiguration(transactionID string) (*StructuredConfiguration, error)
+	// Apply changes the configuration to the desired one, changing only the sections which differ.
+	// One of version or transactionID is mandatory.
+	Apply(desired *StructuredConfiguration, transactionID string, version int64) (*ConfigurationDiff, error)
+}
+
+// StructuredConfiguration is a whole configuration, its sections as returned by the structured getters
+type StructuredConfiguration struct {
+	// Global is the global section, nil is an empty one
+	Global *models.Global
+	// Traces is the traces section, nil when there is none
+	Traces        *models.Traces
+	Defaults      models.DefaultsSections
+	Frontends     models.Frontends
+	Backends      models.Backends
+	Peers         models.PeerSections
+	Resolvers     models.Resolvers
+	Userlists     models.Userlists
+	Mailers       models.MailersSections
+	Caches        models.Caches
+	HTTPErrors    models.HTTPErrorsSections
+	Rings         models.Rings
+	LogForwards   models.LogForwards
+	LogProfiles   models.LogProfiles
+	FCGIApps      models.FCGIApps
+	CrtStores     models.CrtStores
+	AcmeProviders models.AcmeProviders
+	HealthChecks  models.Healthchecks
+}
+
+// GetStructuredConfiguration returns the whole configuration as structured sections,
+// to be changed and applied again
+func (c *client) GetStructuredConfiguration(transactionID string) (*StructuredConfiguration, error) {
+	current, err := c.configurationAt(ConfigurationRef{TransactionID: transactionID})
+	if err != nil {
+		return nil, err
+	}
+	return current.structuredConfiguration()
+}
+
+// Apply changes the configuration to the desired one, with sections created, edited or deleted only
+// where they differ: untouched sections keep their comments. Sections missing from the desired
+// configuration are deleted. The changes are made in the given transaction, or in an implicit one
+// which is committed, no transaction being started when there is nothing to change.
+// It returns the changes made. One of version or transactionID is mandatory.
+func (c *client) Apply(desired *StructuredConfiguration, transactionID string, version int64) (*ConfigurationDiff, error) {
+	if transactionID != "" && version != 0 {
+		return nil, NewConfError(ErrBothVersionTransaction, "Both version and transactionID specified, specify only one")
+	}
+	if transactionID == "" && version == 0 {
+		return nil, NewConfError(ErrNoVersionTransaction, "Version or transactionID not specified, specify only one")
+	}
+	if desired == nil {
+		return nil, NewConfError(ErrValidationError, "desired configuration not specified")
+	}
+
+	current, err := c.configurationAt(ConfigurationRef{TransactionID: transactionID})
+	if err != nil {
+		return nil, err
+	}
+	from, err := current.structuredConfiguration()
+	if err != nil {
+		return nil, err
+	}
+	diff := &ConfigurationDiff{Changes: orderChanges(diffStructured(from, desired))}
+	if diff.FromVersion, err = current.GetVersion(""); err != nil {
+		return nil, err
+	}
+	diff.ToVersion = diff.FromVersion
+	if transactionID == "" && version != diff.FromVersion {
+		return nil, NewConfError(ErrVersionMismatch, fmt.Sprintf("version in configuration file is %v, given version is %v", diff.FromVersion, version))
+	}
+	if diff.Empty() {
+		return diff, nil
+	}
+
+	t := transactionID
+	if t == "" {
+		transaction, err := c.StartTransaction(version)
+		if err != nil {
+			return nil, err
+		}
+		t = transaction.ID
+	}
+	for _, change := range diff.Changes {
+		if err = c.applyChange(change, t); err != nil {
+			if transactionID == "" {
+				return nil, c.ErrAndDeleteTransaction(err, t)
+			}
+			return nil, err
+		}
+	}
+	if transactionID != "" {
+		return diff, nil
+	}
+	if _, err = c.CommitTransaction(t); err != nil {
+		return nil, err
+	}
+	if diff.ToVersion, err = c.GetVersion(""); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// orderChanges orders changes to be applied: sections are created and edited first, defaults
+// before the proxies and the defaults using them, then deleted in the reverse order
+func orderChanges(changes []SectionChange) []SectionChange {
+	ordered := make([]SectionChange, 0, len(changes))
+	var removed []SectionChange
+	for _, change := range changes {
+		if change.Type == ChangeRemoved {
+			removed = append(removed, change)
+			continue
+		}
+		ordered = append(ordered, change)
+	}
+	sortDefaultsChanges(ordered, func(change SectionChange) any { return change.To })
+	sortDefaultsChanges(removed, func(change SectionChange) any { return change.From })
+	slices.Reverse(removed)
+	return append(ordered, removed...)
+}
+
+// sortDefaultsChanges sorts the changes of defaults sections in place, a section after the one it is from
+func sortDefaultsChanges(changes []SectionChange, model func(SectionChange) any) {
+	byName := map[string]SectionChange{}
+	var indexes []int
+	for i, change := range changes {
+		if change.Section == parser.Defaults {
+			byName[change.Name] = change
+			indexes = append(indexes, i)
+		}
+	}
+	sorted := make([]SectionChange, 0, len(indexes))
+	visited := map[string]bool{}
+	var visit func(name string)
+	visit = func(name string) {
+		change, ok := byName[name]
+		if !ok || visited[name] {
+			return
+		}
+		visited[name] = true
+		if d, ok := model(change).(*models.Defaults); ok && d != nil {
+			visit(d.From)
+		}
+		sorted = append(sorted, change)
+	}
+	for _, i := range indexes {
+		visit(changes[i].Name)
+	}
+	for n, i := range indexes {
+		changes[i] = sorted[n]
+	}
+}
+
+// structuredConfiguration returns the configuration of a read-only client as structured sections
+func (c *client) structuredConfiguration() (*StructuredConfiguration, error) { //nolint:gocyclo,cyclop
+	s := &StructuredConfiguration{}
+	var err error
+	if _, s.Global, err = c.GetStructuredGlobalConfiguration(""); err != nil {
+		return nil, err
+	}
+	if c.sectionExists(parser.Traces) {
+		if _, s.Traces, err = c.GetStructuredTraces(""); err != nil {
+			return nil, err
+		}
+	}
+	if _, s.Defaults, err = c.GetStructuredDefaultsSections(""); err != nil {
+		return nil, err
+	}
+	if _, s.Frontends, err = c.GetStructuredFrontends(""); err != nil {
+		return nil, err
+	}
+	if _, s.Backends, err = c.GetStructuredBackends(""); err != nil {
+		return nil, err
+	}
+	if _, s.Peers, err = c.GetStructuredPeerSections(""); err != nil {
+		return nil, err
+	}
+	if _, s.Resolvers, err = c.GetStructuredResolvers(""); err != nil {
+		return nil, err
+	}
+	if _, s.Userlists, err = c.GetStructuredUserLists(""); err != nil {
+		return nil, err
+	}
+	if _, s.Mailers, err = c.GetStructuredMailersSections(""); err != nil {
+		return nil, err
+	}
+	if _, s.Caches, err = c.GetCaches(""); err != nil {
+		return nil, err
+	}
+	if _, s.HTTPErrors, err = c.GetHTTPErrorsSections(""); err != nil {
+		return nil, err
+	}
+	if _, s.Rings, err = c.GetStructuredRings(""); err != nil {
+		return nil, err
+	}
+	if _, s.LogForwards, err = c.GetStructuredLogForwards(""); err != nil {
+		return nil, err
+	}
+	if _, s.LogProfiles, err = c.GetStructuredLogProfiles(""); err != nil {
+		return nil, err
+	}
+	if _, s.FCGIApps, err = c.GetStructuredFCGIApplications(""); err != nil {
+		return nil, err
+	}
+	if _, s.CrtStores, err = c.GetStructuredCrtStores(""); err != nil {
+		return nil, err
+	}
+	if _, s.AcmeProviders, err = c.GetStructuredAcmeProviders(""); err != nil {
+		return nil, err
+	}
+	if _, s.HealthChecks, err = c.GetStructuredHealthchecks(""); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+	"github.com/haproxytech/client-native/v6/configuration/options"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+const applyTestConfig = `# _version=1
+global
+  daemon
+
+defaults unnamed_defaults_1
+  mode http
+
+frontend fe
+  bind 127.0.0.1:8080 name main
+  default_backend be_old
+
+# kept as is
+backend be_kept
+  server s1 127.0.0.1:81
+
+backend be_old
+  server s1 127.0.0.1:82
+`
+
+func TestApply(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "haproxy.cfg")
+	if err := os.WriteFile(cfgFile, []byte(applyTestConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c, err := New(context.Background(),
+		options.ConfigurationFile(cfgFile),
+		options.TransactionsDir(filepath.Join(filepath.Dir(cfgFile), "transactions")),
+		options.HAProxyVersion("3.1"),
+		options.SkipConfigurationFileValidation,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = c.Apply(nil, "", 1); !errors.Is(err, ErrValidationError) {
+		t.Errorf("Apply(nil) error = %v, want ErrValidationError", err)
+	}
+
+	desired, err := c.GetStructuredConfiguration("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(desired.Backends) != 2 || len(desired.Frontends) != 1 {
+		t.Fatalf("unexpected sections: %d backends, %d frontends", len(desired.Backends), len(desired.Frontends))
+	}
+	desired.Frontends[0].DefaultBackend = "be_new"
+	kept := desired.Backends[slices.IndexFunc(desired.Backends, func(b *models.Backend) bool { return b.Name == "be_kept" })]
+	desired.Backends = models.Backends{kept, &models.Backend{BackendBase: models.BackendBase{Name: "be_new", From: "unnamed_defaults_1"}}}
+
+	if _, err = c.Apply(desired, "", 3); !errors.Is(err, ErrVersionMismatch) {
+		t.Fatalf("expected version mismatch, got %v", err)
+	}
+
+	diff, err := c.Apply(desired, "", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.FromVersion != 1 || diff.ToVersion != 2 {
+		t.Errorf("versions %d to %d, expected 1 to 2", diff.FromVersion, diff.ToVersion)
+	}
+	var got []string
+	for _, change := range diff.Changes {
+		got = append(got, string(change.Type)+" "+string(change.Section)+" "+change.Name)
+	}
+	want := []string{
+		string(ChangeModified) + " " + string(parser.Frontends) + " fe",
+		string(ChangeAdded) + " " + string(parser.Backends) + " be_new",
+		string(ChangeRemoved) + " " + string(parser.Backends) + " be_old",
+	}
+	if strings.Join(got, ", ") != strings.Join(want, ", ") {
+		t.Errorf("changes %v, expected %v", got, want)
+	}
+
+	data, err := os.ReadFile(cfgFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"# kept as is", "backend be_new", "default_backend be_new"} {
+		if !strings.Contains(string(data), line) {
+			t.Errorf("missing %q in:\n%s", line, data)
+		}
+	}
+	if strings.Contains(string(data), "be_old") {
+		t.Errorf("be_old not deleted:\n%s", data)
+	}
+
+	// applying it again changes nothing
+	diff, err = c.Apply(desired, "", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.Empty() || diff.ToVersion != 2 {
+		t.Errorf("expected no changes, got %d, version %d", len(diff.Changes), diff.ToVersion)
+	}
+	version, err := c.GetVersion("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != 2 {
+		t.Errorf("version %d, expected 2", version)
+	}
+
+	// defaults are created after the defaults they are from, deleted before
+	applied := *desired
+	applied.Defaults = append(slices.Clone(desired.Defaults),
+		&models.Defaults{DefaultsBase: models.DefaultsBase{Name: "app", From: "zbase"}},
+		&models.Defaults{DefaultsBase: models.DefaultsBase{Name: "zbase"}},
+	)
+	if diff, err = c.Apply(&applied, "", 2); err != nil {
+		t.Fatal(err)
+	}
+	if got := defaultsChanges(diff); got != "added app after added zbase" {
+		t.Errorf("defaults changes: %s", got)
+	}
+	if diff, err = c.Apply(desired, "", 3); err != nil {
+		t.Fatal(err)
+	}
+	if got := defaultsChanges(diff); got != "removed zbase after removed app" {
+		t.Errorf("defaults changes: %s", got)
+	}
+
+	// within a transaction, left open
+	transaction, err := c.StartTransaction(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	desired.Backends = desired.Backends[:1]
+	if diff, err = c.Apply(desired, transaction.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if len(diff.Changes) != 1 || diff.Changes[0].Type != ChangeRemoved || diff.Changes[0].Name != "be_new" {
+		t.Errorf("unexpected changes %+v", diff.Changes)
+	}
+	if _, _, err = c.GetBackend("be_new", transaction.ID); err == nil {
+		t.Error("be_new not deleted in the transaction")
+	}
+	if _, _, err = c.GetBackend("be_new", ""); err != nil {
+		t.Errorf("be_new deleted before the commit: %v", err)
+	}
+}
+
+// defaultsChanges returns the changes of the defaults sections of a diff, the last one first
+func defaultsChanges(diff *ConfigurationDiff) string {
+	var changes []string
+	for _, change := range diff.Changes {
+		if change.Section == parser.Defaults {
+			changes = append([]string{string(change.Type) + " " + change.Name}, changes...)
+		}
+	}
+	return strings.Join(changes, " after ")
+}
@@ -18,7 +18,6 @@ package configuration
 import (
 	"fmt"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 
 	"github.com/haproxytech/client-native/v6/models"
@@ -103,7 +102,7 @@ func (c *client) DeleteBackend(name string, transactionID string, version int64)
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateBackend(data *models.Backend, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -115,7 +114,7 @@ func (c *client) CreateBackend(data *models.Backend, transactionID string, versi
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditBackend(name string, data *models.Backend, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -20,7 +20,6 @@ import (
 	goerrors "errors"
 	"strconv"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -92,7 +91,7 @@ func (c *client) DeleteBackendSwitchingRule(id int64, frontend string, transacti
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateBackendSwitchingRule(id int64, frontend string, data *models.BackendSwitchingRule, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -114,7 +113,7 @@ func (c *client) CreateBackendSwitchingRule(id int64, frontend string, data *mod
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditBackendSwitchingRule(id int64, frontend string, data *models.BackendSwitchingRule, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -140,7 +139,7 @@ func (c *client) EditBackendSwitchingRule(id int64, frontend string, data *model
 // Returns error on fail, nil on success.
 func (c *client) ReplaceBackendSwitchingRules(frontend string, data models.BackendSwitchingRules, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -0,0 +1,151 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+	"github.com/haproxytech/client-native/v6/config-parser/types"
+)
+
+type Backups interface {
+	// GetBackups returns the backups of the configuration file, oldest first.
+	GetBackups() ([]Backup, error)
+	// GetBackup returns a backup with its raw content.
+	GetBackup(version int64) (*Backup, string, error)
+	// DiffBackup returns the changes restoring a backup would make to the current configuration.
+	DiffBackup(version int64) (*ConfigurationDiff, error)
+	// RestoreBackup replaces the configuration with a backup. One of version or transactionID is mandatory.
+	RestoreBackup(backupVersion int64, transactionID string, version int64) error
+}
+
+// Backup is a copy of the configuration file saved before a commit
+type Backup struct {
+	// Version is the version of the backed up configuration
+	Version int64
+	File    string
+	// Timestamp is the time the backup was made
+	Timestamp time.Time
+	Size      int64
+}
+
+// GetBackups returns the backups of the configuration file found in the backups directory, oldest first
+func (c *client) GetBackups() ([]Backup, error) {
+	entries, err := os.ReadDir(c.BackupsDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []Backup{}, nil
+		}
+		return nil, NewConfError(ErrGeneralError, err.Error())
+	}
+	prefix := filepath.Base(c.ConfigurationFile) + "."
+	backups := []Backup{}
+	for _, entry := range entries {
+		suffix, ok := strings.CutPrefix(entry.Name(), prefix)
+		if !ok || entry.IsDir() {
+			continue
+		}
+		// skip transaction and temporary files
+		version, err := strconv.ParseInt(suffix, 10, 64)
+		if err != nil || version <= 0 {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		backups = append(backups, Backup{
+			Version:   version,
+			File:      filepath.Join(c.BackupsDir, entry.Name()),
+			Timestamp: info.ModTime(),
+			Size:      info.Size(),
+		})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Version < backups[j].Version
+	})
+	return backups, nil
+}
+
+// GetBackup returns a backup with its raw content
+func (c *client) GetBackup(version int64) (*Backup, string, error) {
+	if version <= 0 {
+		return nil, "", NewConfError(ErrObjectDoesNotExist, fmt.Sprintf("backup file for version %v does not exist", version))
+	}
+	backupFile, err := c.getBackupFile(version)
+	if err != nil {
+		return nil, "", err
+	}
+	info, err := os.Stat(backupFile)
+	if err != nil {
+		return nil, "", NewConfError(ErrCannotReadConfFile, err.Error())
+	}
+	data, err := os.ReadFile(backupFile)
+	if err != nil {
+		return nil, "", NewConfError(ErrCannotReadConfFile, err.Error())
+	}
+	return &Backup{
+		Version:   version,
+		File:      backupFile,
+		Timestamp: info.ModTime(),
+		Size:      info.Size(),
+	}, string(data), nil
+}
+
+// DiffBackup returns the changes restoring a backup would make to the current configuration
+func (c *client) DiffBackup(version int64) (*ConfigurationDiff, error) {
+	if version <= 0 {
+		return nil, NewConfError(ErrObjectDoesNotExist, fmt.Sprintf("backup file for version %v does not exist", version))
+	}
+	return c.DiffConfiguration(ConfigurationRef{}, ConfigurationRef{Version: version})
+}
+
+// RestoreBackup replaces the configuration with a backup, as any other change: in the given
+// transaction, or in an implicit one which is validated and committed, bumping the version.
+// One of version or transactionID is mandatory.
+func (c *client) RestoreBackup(backupVersion int64, transactionID string, version int64) error {
+	_, data, err := c.GetBackup(backupVersion)
+	if err != nil {
+		return err
+	}
+
+	p, t, err := c.loadDataForChange(transactionID, version)
+	if err != nil {
+		return err
+	}
+	tVersion, err := c.GetVersion(t)
+	if err != nil {
+		return c.HandleError("", "", "", t, transactionID == "", err)
+	}
+	if err = p.Process(strings.NewReader(data)); err != nil {
+		e := NewConfError(ErrCannotReadConfFile, fmt.Sprintf("cannot read backup of version %d: %s", backupVersion, err.Error()))
+		return c.HandleError("", "", "", t, transactionID == "", e)
+	}
+	// keep the version of the transaction, the backup has the older one or none
+	if err = p.Set(parser.Comments, parser.CommentsSectionName, "# _version", &types.ConfigVersion{Value: tVersion}); err != nil {
+		return c.HandleError("", "", "", t, transactionID == "", NewConfError(ErrCannotSetVersion, err.Error()))
+	}
+
+	return c.SaveData(p, t, transactionID == "")
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/configuration/options"
+)
+
+func TestBackups(t *testing.T) {
+	c, cfgFile := newDiffTestClient(t, options.Backups(3))
+	// transaction files must not be listed
+	if err := os.WriteFile(cfgFile+".not-a-backup", []byte("# _version=1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.DeleteBackend("be_old", "", 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeleteBackend("be_static", "", 3); err != nil {
+		t.Fatal(err)
+	}
+
+	backups, err := c.GetBackups()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 2 || backups[0].Version != 2 || backups[1].Version != 3 {
+		t.Fatalf("backups = %+v, want versions 2 and 3", backups)
+	}
+	if backups[0].File != cfgFile+".2" || backups[0].Timestamp.IsZero() || backups[0].Size == 0 {
+		t.Errorf("backup = %+v, want file, timestamp and size", backups[0])
+	}
+
+	backup, data, err := c.GetBackup(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backup.Version != 2 || !strings.Contains(data, "backend be_old") {
+		t.Errorf("backup %+v content:\n%s\nwant be_old", backup, data)
+	}
+	if _, _, err = c.GetBackup(1); !errors.Is(err, ErrObjectDoesNotExist) {
+		t.Errorf("GetBackup() of a missing version error = %v, want ErrObjectDoesNotExist", err)
+	}
+
+	diff, err := c.DiffBackup(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff.Changes) != 2 || diff.Changes[0].Type != ChangeAdded || diff.Changes[0].Name != "be_old" || diff.Changes[1].Name != "be_static" {
+		t.Errorf("changes = %+v, want be_old and be_static added", diff.Changes)
+	}
+
+	if err = c.RestoreBackup(2, "", 3); !errors.Is(err, ErrVersionMismatch) {
+		t.Errorf("RestoreBackup() with an old version error = %v, want ErrVersionMismatch", err)
+	}
+	if err = c.RestoreBackup(2, "", 4); err != nil {
+		t.Fatal(err)
+	}
+	version, err := c.GetVersion("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != 5 {
+		t.Errorf("version after restore = %d, want 5", version)
+	}
+	for _, name := range []string{"be_old", "be_static"} {
+		if _, _, err = c.GetBackend(name, ""); err != nil {
+			t.Errorf("GetBackend(%s) after restore error = %v", name, err)
+		}
+	}
+	diff, err = c.DiffBackup(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.Empty() {
+		t.Errorf("diff with the restored backup = %+v, want empty", diff.Changes)
+	}
+
+	// a backup without version gets the one of the transaction
+	var lines []string
+	for line := range strings.SplitSeq(data, "\n") {
+		if !strings.HasPrefix(line, "# _version") {
+			lines = append(lines, line)
+		}
+	}
+	if err = os.WriteFile(cfgFile+".9", []byte(strings.Join(lines, "\n")), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.RestoreBackup(9, "", 5); err != nil {
+		t.Fatal(err)
+	}
+	if version, err = c.GetVersion(""); err != nil || version != 6 {
+		t.Errorf("version after restoring a backup without version = %d, %v, want 6", version, err)
+	}
+	if content, _ := os.ReadFile(cfgFile); !strings.Contains(string(content), "# _version=6") {
+		t.Errorf("configuration file after restore:\n%s\nwant version 6", content)
+	}
+}
@@ -21,7 +21,6 @@ import (
 	"strconv"
 	"strings"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/params"
@@ -107,7 +106,7 @@ func (c *client) DeleteBind(name string, parentType string, parentName string, t
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateBind(parentType string, parentName string, data *models.Bind, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -139,7 +138,7 @@ func (c *client) CreateBind(parentType string, parentName string, data *models.B
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditBind(name string, parentType string, parentName string, data *models.Bind, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
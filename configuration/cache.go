@@ -19,7 +19,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/common"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
@@ -115,7 +114,7 @@ func (c *client) DeleteCache(name string, transactionID string, version int64) e
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditCache(name string, data *models.Cache, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -142,7 +141,7 @@ func (c *client) EditCache(name string, data *models.Cache, transactionID string
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateCache(data *models.Cache, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
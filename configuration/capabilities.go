@@ -0,0 +1,59 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/haproxytech/client-native/v6/capabilities"
+)
+
+type Capabilities interface {
+	// HAProxyVersion returns the version of the HAProxy binary, nil when unknown
+	HAProxyVersion() *semver.Version
+	// CheckCapability returns an error if the HAProxy version doesn't support a keyword or option.
+	// When the version is unknown, everything is considered supported.
+	CheckCapability(kind capabilities.Kind, name string) error
+}
+
+// HAProxyVersion returns the version of the HAProxy binary, nil when unknown
+func (c *client) HAProxyVersion() *semver.Version {
+	return c.haproxyVersion
+}
+
+// CheckCapability returns an error if the HAProxy version doesn't support a keyword or option
+func (c *client) CheckCapability(kind capabilities.Kind, name string) error {
+	if err := capabilities.Default().Check(c.haproxyVersion, kind, name); err != nil {
+		return &ConfError{err: ErrUnsupported, reason: err.Error(), cause: err}
+	}
+	return nil
+}
+
+// parseHAProxyVersion returns the version from the output of `haproxy -v`,
+// such as "HAProxy version 3.1.2-1ppa1~noble 2025/01/17 - https://haproxy.org/"
+func parseHAProxyVersion(output string) (*semver.Version, error) {
+	_, version, found := strings.Cut(output, "HAProxy version ")
+	if !found {
+		return nil, errors.New("not a haproxy version string")
+	}
+	fields := strings.Fields(version)
+	if len(fields) == 0 {
+		return nil, errors.New("not a haproxy version string")
+	}
+	return semver.NewVersion(fields[0])
+}
@@ -19,7 +19,6 @@ import (
 	"errors"
 	"strconv"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -94,7 +93,7 @@ func (c *client) DeleteDeclareCapture(index int64, frontend string, transactionI
 // Returns error on fail, nil on success
 func (c *client) CreateDeclareCapture(index int64, frontend string, data *models.Capture, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -113,7 +112,7 @@ func (c *client) CreateDeclareCapture(index int64, frontend string, data *models
 // Returns error on fail, nil on success.
 func (c *client) EditDeclareCapture(index int64, frontend string, data *models.Capture, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -133,7 +132,7 @@ func (c *client) EditDeclareCapture(index int64, frontend string, data *models.C
 // Returns error on fail, nil on success.
 func (c *client) ReplaceDeclareCaptures(frontend string, data models.Captures, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -23,11 +23,15 @@ import (
 	"strings"
 
 	"github.com/haproxytech/client-native/v6/configuration/options"
+	"github.com/haproxytech/client-native/v6/diagnostics"
 	shellquote "github.com/kballard/go-shellquote"
 )
 
+// checkHaproxyConfiguration validates a configuration file with HAProxy. It returns the diagnostics
+// of the check, warnings when the configuration is valid, else a ConfError with the alerts.
+//
 //nolint:noctx
-func checkHaproxyConfiguration(opt options.ConfigurationOptions, path string, transactionID ...string) error {
+func checkHaproxyConfiguration(opt options.ConfigurationOptions, path string, transactionID ...string) (diagnostics.Diagnostics, error) {
 	var name string
 	var args []string
 
@@ -51,6 +55,12 @@ func checkHaproxyConfiguration(opt options.ConfigurationOptions, path string, tr
 		args = addConfigFilesToArgs(args, opt)
 	}
 
+	if len(opt.ValidateCmd) == 0 {
+		if _, err := exec.LookPath(name); err != nil {
+			return nil, NewConfError(ErrCannotFindHAProxy, fmt.Sprintf("cannot validate configuration with '%s -c', HAProxy binary not found: %s", name, err.Error()))
+		}
+	}
+
 	// #nosec G204
 	cmd := exec.Command(name, args...)
 	cmd.Env = envs
@@ -58,11 +68,15 @@ func checkHaproxyConfiguration(opt options.ConfigurationOptions, path string, tr
 	cmd.Stderr = &stderr
 
 	err := cmd.Run()
+	diags := diagnostics.Parse(stderr.String())
+	diagnostics.ResolveSections(diags)
 	if err != nil {
 		errStr := fmt.Sprintf("%s: %s", err.Error(), parseHAProxyCheckError(stderr.String(), transactionID...))
-		return NewConfError(ErrValidationError, errStr)
+		confErr := NewConfError(ErrValidationError, errStr)
+		confErr.diagnostics = diags
+		return nil, confErr
 	}
-	return nil
+	return diags, nil
 }
 
 func parseHAProxyCheckError(output string, transactionID ...string) string { //nolint:gocognit
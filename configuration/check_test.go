@@ -0,0 +1,78 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/configuration/options"
+	"github.com/haproxytech/client-native/v6/diagnostics"
+)
+
+func TestCheckHaproxyConfiguration_Diagnostics(t *testing.T) {
+	cfg := filepath.Join(t.TempDir(), "haproxy.cfg")
+	if err := os.WriteFile(cfg, []byte("global\n  daemon\n\nbackend be_app\n  foo bar\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	alert := "[ALERT]    (1) : config : parsing [" + cfg + ":5] : unknown keyword 'foo' in 'backend' section"
+	opt := options.ConfigurationOptions{
+		ValidateCmd: `sh -c "echo \"` + alert + `\" >&2; exit 1"`,
+	}
+
+	_, err := checkHaproxyConfiguration(opt, cfg)
+	var confErr *ConfError
+	if !errors.As(err, &confErr) || !errors.Is(err, ErrValidationError) {
+		t.Fatalf("checkHaproxyConfiguration() error = %v, want a validation error", err)
+	}
+	diags := confErr.Diagnostics()
+	if len(diags) != 1 {
+		t.Fatalf("Diagnostics() = %+v, want 1 diagnostic", diags)
+	}
+	want := diagnostics.Diagnostic{
+		Severity: diagnostics.SeverityAlert,
+		File:     cfg,
+		Line:     5,
+		Section:  "backend be_app",
+		Keyword:  "foo",
+		Message:  "unknown keyword 'foo' in 'backend' section",
+		Raw:      alert,
+	}
+	if diags[0] != want {
+		t.Errorf("Diagnostics()[0] = %+v, want %+v", diags[0], want)
+	}
+}
+
+func TestCheckHaproxyConfiguration_Warnings(t *testing.T) {
+	cfg := filepath.Join(t.TempDir(), "haproxy.cfg")
+	if err := os.WriteFile(cfg, []byte("global\n  daemon\n\nbackend be_app\n  timeout server 1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	warning := "[WARNING]  (1) : config : parsing [" + cfg + ":5] : 'timeout server' will be ignored"
+	opt := options.ConfigurationOptions{
+		ValidateCmd: `sh -c "echo \"` + warning + `\" >&2"`,
+	}
+
+	diags, err := checkHaproxyConfiguration(opt, cfg)
+	if err != nil {
+		t.Fatalf("checkHaproxyConfiguration() error = %v", err)
+	}
+	if len(diags) != 1 || diags[0].Severity != diagnostics.SeverityWarning || diags[0].Line != 5 || diags[0].Section != "backend be_app" {
+		t.Errorf("checkHaproxyConfiguration() diagnostics = %+v, want the warning of line 5", diags)
+	}
+}
@@ -23,6 +23,8 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/Masterminds/semver/v3"
+	"github.com/haproxytech/client-native/v6/capabilities"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/common"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
@@ -84,10 +86,17 @@ type ClientParams struct {
 type client struct {
 	Transaction
 
-	parser   parser.Parser
-	parsers  map[string]parser.Parser
-	services map[string]*Service
-	clientMu sync.Mutex
+	parser         parser.Parser
+	parsers        map[string]parser.Parser
+	services       map[string]*Service
+	haproxyVersion *semver.Version
+	clientMu       sync.Mutex
+	// readOnly clients are views of the configuration which can't be changed
+	readOnly bool
+	// commits which could not be recorded in the journal, with the last failure
+	journalFailures int64
+	journalErr      error
+	journalMu       sync.Mutex
 }
 
 // SetValidateConfigFiles set before and after validation files
@@ -147,10 +156,15 @@ func (c *client) AddParser(transactionID string) error {
 		if err != nil {
 			return err
 		}
+		parserOptions = append(parserOptions, parser_options.Path(tFile))
 	} else {
 		tFile = c.ConfigurationFile
+		source, errSource := c.configurationSource()
+		if errSource != nil {
+			return errSource
+		}
+		parserOptions = append(parserOptions, source)
 	}
-	parserOptions = append(parserOptions, parser_options.Path(tFile))
 	p, err := parser.New(parserOptions...)
 	if err != nil {
 		return NewConfError(ErrCannotReadConfFile, "Cannot read "+tFile)
@@ -215,31 +229,50 @@ func (c *client) IncrementVersion() error {
 	ver, _ := data.(*types.ConfigVersion)
 	ver.Value++
 
-	if err := c.parser.Save(c.ConfigurationFile); err != nil {
+	if err := c.saveConfiguration(c.parser); err != nil {
 		return NewConfError(ErrCannotSetVersion, err.Error())
 	}
 	return nil
 }
 
 func (c *client) LoadData(filename string) error {
-	err := c.parser.LoadData(filename)
+	var err error
+	if filename == c.ConfigurationFile && len(c.ConfigurationFiles) > 0 {
+		var data string
+		if data, err = c.readConfiguration(); err == nil {
+			err = c.parser.Process(strings.NewReader(data))
+		}
+	} else {
+		err = c.parser.LoadData(filename)
+	}
 	if err != nil {
 		return NewConfError(ErrCannotReadConfFile, "cannot read "+filename)
 	}
 	return nil
 }
 
+// Save writes the configuration of a transaction, or of the master parser, to a file. Saved
+// to the configuration file, the sections are written back to the files they belong to.
 func (c *client) Save(transactionFile, transactionID string) error {
-	if transactionID == "" {
-		return c.parser.Save(transactionFile)
-	}
 	p, err := c.GetParser(transactionID)
 	if err != nil {
 		return err
 	}
+	if transactionFile == c.ConfigurationFile {
+		return c.saveConfiguration(p)
+	}
 	return p.Save(transactionFile)
 }
 
+// Render returns the configuration of a transaction as it would be saved
+func (c *client) Render(transactionID string) (string, error) {
+	p, err := c.GetParser(transactionID)
+	if err != nil {
+		return "", err
+	}
+	return p.String(), nil
+}
+
 // ParseSection sets the fields of the section based on the provided parser
 func ParseSection(object any, section parser.Section, pName string, p parser.Parser) error {
 	sp := &SectionParser{
@@ -1559,6 +1592,8 @@ type SectionObject struct {
 	Section parser.Section
 	Name    string
 	Options *options.ConfigurationOptions
+	// CheckCapability, when set, rejects the options the HAProxy version doesn't support
+	CheckCapability func(kind capabilities.Kind, name string) error
 }
 
 // CreateEditSection creates or updates a section in the parser based on the provided object
@@ -1769,6 +1804,11 @@ func (s *SectionObject) checkTimeouts(fieldName string, field reflect.Value) (bo
 	if strings.HasSuffix(fieldName, "Timeout") {
 		if pName := translateTimeout(fieldName); s.Parser.HasParser(s.Section, pName) {
 			if valueIsNil(field) {
+				// a timeout set with an environment variable cannot be read into
+				// the model, keep it as it is
+				if s.timeoutHasVariable(pName) {
+					return true, nil
+				}
 				if err := s.set(pName, nil); err != nil {
 					return true, err
 				}
@@ -1785,6 +1825,15 @@ func (s *SectionObject) checkTimeouts(fieldName string, field reflect.Value) (bo
 	return false, nil
 }
 
+func (s *SectionObject) timeoutHasVariable(pName string) bool {
+	data, err := s.Parser.Get(s.Section, s.Name, pName, false)
+	if err != nil {
+		return false
+	}
+	timeout, ok := data.(*types.SimpleTimeout)
+	return ok && strings.Contains(timeout.Value, "$")
+}
+
 func (s *SectionObject) checkOptions(fieldName string, field reflect.Value) (bool, error) {
 	if pName := "option " + misc.DashCase(fieldName); s.Parser.HasParser(s.Section, pName) {
 		if valueIsNil(field) {
@@ -1827,6 +1876,13 @@ func (s *SectionObject) checkSingleLine(fieldName string, field reflect.Value) (
 	return false, nil
 }
 
+func (s *SectionObject) checkCapability(kind capabilities.Kind, name string) error {
+	if s.CheckCapability == nil {
+		return nil
+	}
+	return s.CheckCapability(kind, name)
+}
+
 func (s *SectionObject) set(attribute string, data any) error {
 	return s.Parser.Set(s.Section, s.Name, attribute, data)
 }
@@ -2903,6 +2959,9 @@ func (s *SectionObject) httpRestrictReqHdrNames(field reflect.Value) error {
 	if valueIsNil(field) {
 		return s.set("option http-restrict-req-hdr-names", nil)
 	}
+	if err := s.checkCapability(capabilities.Option, capabilities.OptionHTTPRestrictReqHdrNames); err != nil {
+		return err
+	}
 	t := &types.OptionHTTPRestrictReqHdrNames{Policy: field.String()}
 	return s.set("option http-restrict-req-hdr-names", t)
 }
@@ -3126,7 +3185,15 @@ func (c *client) editSection(section parser.Section, name string, data any, tran
 		return c.HandleError(name, "", "", t, transactionID == "", e)
 	}
 
-	if err := CreateEditSection(data, section, name, p, &c.ConfigurationOptions); err != nil {
+	so := SectionObject{
+		Object:          data,
+		Section:         section,
+		Name:            name,
+		Parser:          p,
+		Options:         &c.ConfigurationOptions,
+		CheckCapability: c.CheckCapability,
+	}
+	if err := so.CreateEditSection(); err != nil {
 		return c.HandleError(name, "", "", t, transactionID == "", err)
 	}
 
@@ -3148,7 +3215,15 @@ func (c *client) createSection(section parser.Section, name string, data any, tr
 		return c.HandleError(name, "", "", t, transactionID == "", err)
 	}
 
-	if err := CreateEditSection(data, section, name, p, &c.ConfigurationOptions); err != nil {
+	so := SectionObject{
+		Object:          data,
+		Section:         section,
+		Name:            name,
+		Parser:          p,
+		Options:         &c.ConfigurationOptions,
+		CheckCapability: c.CheckCapability,
+	}
+	if err := so.CreateEditSection(); err != nil {
 		return c.HandleError(name, "", "", t, transactionID == "", err)
 	}
 
@@ -3156,6 +3231,9 @@ func (c *client) createSection(section parser.Section, name string, data any, tr
 }
 
 func (c *client) loadDataForChange(transactionID string, version int64) (parser.Parser, string, error) {
+	if c.readOnly {
+		return nil, "", NewConfError(ErrReadOnly, "views of the configuration can't be changed")
+	}
 	t, err := c.TransactionClient.CheckTransactionOrVersion(transactionID, version)
 	if err != nil {
 		// if transactionID is implicit, return err and delete transaction
@@ -19,7 +19,7 @@ import (
 	"errors"
 	"fmt"
 
-	strfmt "github.com/go-openapi/strfmt"
+	"github.com/haproxytech/client-native/v6/capabilities"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/common"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
@@ -107,8 +107,12 @@ func (c *client) DeleteCrtStore(name, transactionID string, version int64) error
 }
 
 func (c *client) CreateCrtStore(data *models.CrtStore, transactionID string, version int64) error {
+	if err := c.CheckCapability(capabilities.Keyword, capabilities.KeywordCrtStore); err != nil {
+		return err
+	}
+
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -137,7 +141,7 @@ func (c *client) CreateCrtStore(data *models.CrtStore, transactionID string, ver
 
 func (c *client) EditCrtStore(name string, data *models.CrtStore, transactionID string, version int64) error { //nolint:revive
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -18,7 +18,6 @@ package configuration
 import (
 	"fmt"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 
 	"github.com/haproxytech/client-native/v6/models"
@@ -57,7 +56,7 @@ func (c *client) GetDefaultsConfiguration(transactionID string) (int64, *models.
 // config file
 func (c *client) PushDefaultsConfiguration(data *models.Defaults, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -131,7 +130,7 @@ func (c *client) DeleteDefaultsSection(name string, transactionID string, versio
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditDefaultsSection(name string, data *models.Defaults, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -144,7 +143,7 @@ func (c *client) EditDefaultsSection(name string, data *models.Defaults, transac
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateDefaultsSection(data *models.Defaults, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
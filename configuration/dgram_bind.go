@@ -21,7 +21,6 @@ import (
 	"strconv"
 	"strings"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/params"
@@ -106,7 +105,7 @@ func (c *client) DeleteDgramBind(name string, logForward string, transactionID s
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateDgramBind(logForward string, data *models.DgramBind, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -138,7 +137,7 @@ func (c *client) CreateDgramBind(logForward string, data *models.DgramBind, tran
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditDgramBind(name string, logForward string, data *models.DgramBind, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -0,0 +1,569 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+	parser_options "github.com/haproxytech/client-native/v6/config-parser/options"
+	"github.com/haproxytech/client-native/v6/models"
+	"github.com/haproxytech/go-method-gen/pkg/eqdiff"
+)
+
+type Diff interface {
+	// DiffConfiguration returns the changes needed to go from one configuration to another.
+	DiffConfiguration(from, to ConfigurationRef) (*ConfigurationDiff, error)
+}
+
+// ConfigurationRef references a configuration: an in progress transaction, a version
+// (the current configuration or one of its backups), or the current configuration
+// when both are empty.
+type ConfigurationRef struct {
+	TransactionID string
+	Version       int64
+}
+
+// ChangeType is the type of change of a configuration section
+type ChangeType string
+
+const (
+	ChangeAdded    ChangeType = "added"
+	ChangeRemoved  ChangeType = "removed"
+	ChangeModified ChangeType = "modified"
+)
+
+// SectionChange is a change of a configuration section
+type SectionChange struct {
+	Type    ChangeType
+	Section parser.Section
+	// Name is empty for unnamed sections, like global
+	Name string
+	// Fields are the field-level differences of modified sections, indexed by field path,
+	// with the value before and after the change
+	Fields map[string][]any
+	// From is the section before the change, nil if it was added
+	From any
+	// To is the section after the change, nil if it was removed
+	To any
+}
+
+// ConfigurationDiff is a set of changes between two configurations
+type ConfigurationDiff struct {
+	FromVersion int64
+	ToVersion   int64
+	Changes     []SectionChange
+}
+
+// Empty returns true if both configurations are the same
+func (d *ConfigurationDiff) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// DiffConfiguration returns the changes between two configurations, as a list
+// of added, removed and modified sections with their field-level differences.
+// Sections are compared with their child resources, as returned by the structured getters.
+func (c *client) DiffConfiguration(from, to ConfigurationRef) (*ConfigurationDiff, error) {
+	fromClient, err := c.configurationAt(from)
+	if err != nil {
+		return nil, err
+	}
+	toClient, err := c.configurationAt(to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &ConfigurationDiff{}
+	if diff.FromVersion, err = fromClient.GetVersion(""); err != nil {
+		return nil, err
+	}
+	if diff.ToVersion, err = toClient.GetVersion(""); err != nil {
+		return nil, err
+	}
+	if diff.Changes, err = diffSnapshots(fromClient, toClient); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// diffSnapshots returns the changes between the configurations of two read-only clients
+func diffSnapshots(from, to *client) ([]SectionChange, error) {
+	f, err := from.structuredConfiguration()
+	if err != nil {
+		return nil, err
+	}
+	t, err := to.structuredConfiguration()
+	if err != nil {
+		return nil, err
+	}
+	return diffStructured(f, t), nil
+}
+
+// configurationAt returns a read-only client with a snapshot of the referenced configuration.
+// Transaction parsers don't set the implicit defaults section of proxies, so all configurations
+// are parsed again the same way, like the configuration file.
+func (c *client) configurationAt(ref ConfigurationRef) (*client, error) {
+	if ref.TransactionID != "" && ref.Version != 0 {
+		return nil, NewConfError(ErrBothVersionTransaction, "Both version and transactionID specified, specify only one")
+	}
+
+	var data string
+	current, err := c.GetVersion("")
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case ref.TransactionID != "":
+		p, err := c.GetParser(ref.TransactionID)
+		if err != nil {
+			return nil, err
+		}
+		data = p.String()
+	case ref.Version == 0 || ref.Version == current:
+		data = c.parser.String()
+	default:
+		backup, err := c.getBackupFile(ref.Version)
+		if err != nil {
+			return nil, err
+		}
+		b, err := os.ReadFile(backup)
+		if err != nil {
+			return nil, NewConfError(ErrCannotReadConfFile, err.Error())
+		}
+		data = string(b)
+	}
+
+	return c.snapshot(data)
+}
+
+// snapshot returns a read-only client for the given configuration data
+func (c *client) snapshot(data string) (*client, error) {
+	parserOptions := []parser_options.ParserOption{}
+	if c.UseMd5Hash {
+		parserOptions = append(parserOptions, parser_options.UseMd5Hash)
+	}
+	if noNamedDefaultsFrom(c.haproxyVersion) {
+		parserOptions = append(parserOptions, parser_options.NoNamedDefaultsFrom)
+	}
+	parserOptions = append(parserOptions, parser_options.String(data))
+	p, err := parser.New(parserOptions...)
+	if err != nil {
+		return nil, NewConfError(ErrCannotReadConfFile, err.Error())
+	}
+	return &client{parser: p, haproxyVersion: c.haproxyVersion}, nil
+}
+
+// differ is a pointer to a model with a generated Diff method
+type differ[T any] interface {
+	*T
+	Diff(obj T, opts ...eqdiff.GoMethodGenOptions) map[string][]interface{}
+}
+
+// diffStructured returns the changes between two structured configurations, section type by section
+// type in the order of the changes. A missing global section is an empty one.
+func diffStructured(from, to *StructuredConfiguration) []SectionChange {
+	fromGlobal, toGlobal := from.Global, to.Global
+	if fromGlobal == nil {
+		fromGlobal = &models.Global{}
+	}
+	if toGlobal == nil {
+		toGlobal = &models.Global{}
+	}
+	changes := diffUnnamed(parser.Global, fromGlobal, toGlobal, true, true)
+	changes = append(changes, diffUnnamed(parser.Traces, from.Traces, to.Traces, from.Traces != nil, to.Traces != nil)...)
+	changes = append(changes, diffNamed(parser.Defaults, from.Defaults, to.Defaults, func(d *models.Defaults) string { return d.Name })...)
+	changes = append(changes, diffNamed(parser.Frontends, from.Frontends, to.Frontends, func(f *models.Frontend) string { return f.Name })...)
+	changes = append(changes, diffNamed(parser.Backends, from.Backends, to.Backends, func(b *models.Backend) string { return b.Name })...)
+	changes = append(changes, diffNamed(parser.Peers, from.Peers, to.Peers, func(p *models.PeerSection) string { return p.Name })...)
+	changes = append(changes, diffNamed(parser.Resolvers, from.Resolvers, to.Resolvers, func(r *models.Resolver) string { return r.Name })...)
+	changes = append(changes, diffNamed(parser.UserList, from.Userlists, to.Userlists, func(u *models.Userlist) string { return u.Name })...)
+	changes = append(changes, diffNamed(parser.Mailers, from.Mailers, to.Mailers, func(m *models.MailersSection) string { return m.Name })...)
+	changes = append(changes, diffNamed(parser.Cache, from.Caches, to.Caches, cacheName)...)
+	changes = append(changes, diffNamed(parser.HTTPErrors, from.HTTPErrors, to.HTTPErrors, func(h *models.HTTPErrorsSection) string { return h.Name })...)
+	changes = append(changes, diffNamed(parser.Ring, from.Rings, to.Rings, func(r *models.Ring) string { return r.Name })...)
+	changes = append(changes, diffNamed(parser.LogForward, from.LogForwards, to.LogForwards, func(l *models.LogForward) string { return l.Name })...)
+	changes = append(changes, diffNamed(parser.LogProfile, from.LogProfiles, to.LogProfiles, func(l *models.LogProfile) string { return l.Name })...)
+	changes = append(changes, diffNamed(parser.FCGIApp, from.FCGIApps, to.FCGIApps, func(f *models.FCGIApp) string { return f.Name })...)
+	changes = append(changes, diffNamed(parser.CrtStore, from.CrtStores, to.CrtStores, func(c *models.CrtStore) string { return c.Name })...)
+	changes = append(changes, diffNamed(parser.Acme, from.AcmeProviders, to.AcmeProviders, func(a *models.AcmeProvider) string { return a.Name })...)
+	changes = append(changes, diffNamed(parser.HealthChecks, from.HealthChecks, to.HealthChecks, func(h *models.HealthCheck) string { return h.Name })...)
+	return changes
+}
+
+func (c *client) sectionExists(section parser.Section) bool {
+	sections, err := c.parser.SectionsGet(section)
+	return err == nil && len(sections) > 0
+}
+
+// diffUnnamed compares a section which can be defined only once
+func diffUnnamed[T any, P differ[T]](section parser.Section, from, to P, fromExists, toExists bool) []SectionChange {
+	switch {
+	case !fromExists && !toExists:
+		return nil
+	case !fromExists:
+		return []SectionChange{{Type: ChangeAdded, Section: section, To: to}}
+	case !toExists:
+		return []SectionChange{{Type: ChangeRemoved, Section: section, From: from}}
+	}
+	if fields := from.Diff(*to); len(fields) > 0 {
+		return []SectionChange{{Type: ChangeModified, Section: section, Fields: fields, From: from, To: to}}
+	}
+	return nil
+}
+
+// diffNamed compares two lists of named sections by their name
+func diffNamed[T any, P differ[T], S ~[]P](section parser.Section, fromList, toList S, name func(P) string) []SectionChange {
+	fromByName := make(map[string]P, len(fromList))
+	for _, f := range fromList {
+		fromByName[name(f)] = f
+	}
+	toByName := make(map[string]P, len(toList))
+	for _, t := range toList {
+		toByName[name(t)] = t
+	}
+
+	var changes []SectionChange
+	for n, f := range fromByName {
+		t, ok := toByName[n]
+		if !ok {
+			changes = append(changes, SectionChange{Type: ChangeRemoved, Section: section, Name: n, From: f})
+			continue
+		}
+		if fields := f.Diff(*t); len(fields) > 0 {
+			changes = append(changes, SectionChange{Type: ChangeModified, Section: section, Name: n, Fields: fields, From: f, To: t})
+		}
+	}
+	for n, t := range toByName {
+		if _, ok := fromByName[n]; !ok {
+			changes = append(changes, SectionChange{Type: ChangeAdded, Section: section, Name: n, To: t})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+func cacheName(c *models.Cache) string {
+	if c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// applyChange applies a change of a diff to a transaction
+func (c *client) applyChange(change SectionChange, transactionID string) error { //nolint:gocyclo,cyclop
+	if change.Type == ChangeRemoved {
+		if change.Section == parser.Traces {
+			return c.DeleteTraces(transactionID, 0)
+		}
+		return c.deleteSection(change.Section, change.Name, transactionID, 0)
+	}
+	create := change.Type == ChangeAdded
+	switch data := change.To.(type) {
+	case *models.Global:
+		return c.PushStructuredGlobalConfiguration(data, transactionID, 0)
+	case *models.Traces:
+		return c.PushStructuredTraces(data, transactionID, 0)
+	case *models.Defaults:
+		if create {
+			return c.CreateStructuredDefaultsSection(data, transactionID, 0)
+		}
+		return c.EditStructuredDefaultsSection(change.Name, data, transactionID, 0)
+	case *models.Frontend:
+		if create {
+			return c.CreateStructuredFrontend(data, transactionID, 0)
+		}
+		if from, ok := change.From.(*models.Frontend); ok && from != nil {
+			return c.applyFrontendEdit(from, data, transactionID)
+		}
+		return c.EditStructuredFrontend(change.Name, data, transactionID, 0)
+	case *models.Backend:
+		if create {
+			return c.CreateStructuredBackend(data, transactionID, 0)
+		}
+		if from, ok := change.From.(*models.Backend); ok && from != nil {
+			return c.applyBackendEdit(from, data, transactionID)
+		}
+		return c.EditStructuredBackend(change.Name, data, transactionID, 0)
+	case *models.PeerSection:
+		if create {
+			return c.CreateStructuredPeerSection(data, transactionID, 0)
+		}
+		return c.EditStructuredPeerSection(data, transactionID, 0)
+	case *models.Resolver:
+		if create {
+			return c.CreateStructuredResolver(data, transactionID, 0)
+		}
+		return c.EditStructuredResolver(change.Name, data, transactionID, 0)
+	case *models.Userlist:
+		// userlists can't be edited, only replaced
+		if !create {
+			if err := c.DeleteUserList(change.Name, transactionID, 0); err != nil {
+				return err
+			}
+		}
+		return c.CreateStructuredUserList(data, transactionID, 0)
+	case *models.MailersSection:
+		if create {
+			return c.CreateStructuredMailersSection(data, transactionID, 0)
+		}
+		return c.EditStructuredMailersSection(change.Name, data, transactionID, 0)
+	case *models.Cache:
+		if create {
+			return c.CreateCache(data, transactionID, 0)
+		}
+		return c.EditCache(change.Name, data, transactionID, 0)
+	case *models.HTTPErrorsSection:
+		if create {
+			return c.CreateHTTPErrorsSection(data, transactionID, 0)
+		}
+		return c.EditHTTPErrorsSection(change.Name, data, transactionID, 0)
+	case *models.Ring:
+		if create {
+			return c.CreateStructuredRing(data, transactionID, 0)
+		}
+		return c.EditStructuredRing(change.Name, data, transactionID, 0)
+	case *models.LogForward:
+		if create {
+			return c.CreateStructuredLogForward(data, transactionID, 0)
+		}
+		return c.EditStructuredLogForward(change.Name, data, transactionID, 0)
+	case *models.LogProfile:
+		if create {
+			return c.CreateStructuredLogProfile(data, transactionID, 0)
+		}
+		return c.EditStructuredLogProfile(change.Name, data, transactionID, 0)
+	case *models.FCGIApp:
+		if create {
+			return c.CreateStructuredFCGIApplication(data, transactionID, 0)
+		}
+		return c.EditStructuredFCGIApplication(change.Name, data, transactionID, 0)
+	case *models.CrtStore:
+		if create {
+			return c.CreateStructuredCrtStore(data, transactionID, 0)
+		}
+		return c.EditStructuredCrtStore(change.Name, data, transactionID, 0)
+	case *models.AcmeProvider:
+		if create {
+			return c.CreateStructuredAcmeProvider(data, transactionID, 0)
+		}
+		return c.EditStructuredAcmeProvider(change.Name, data, transactionID, 0)
+	case *models.HealthCheck:
+		if create {
+			return c.CreateStructuredHealthcheck(data, transactionID, 0)
+		}
+		return c.EditStructuredHealthcheck(change.Name, data, transactionID, 0)
+	}
+	return NewConfError(ErrGeneralError, fmt.Sprintf("cannot apply a change of %s %s", change.Section, change.Name))
+}
+
+// applyFrontendEdit changes a frontend from one state to another, only the changed attributes and
+// children being written so that the comments of the section are kept
+func (c *client) applyFrontendEdit(from, to *models.Frontend, transactionID string) error { //nolint:gocognit,gocyclo,cyclop
+	name := to.Name
+	// lists which can only be set with the whole section
+	if !from.FilterSequenceList.Equal(to.FilterSequenceList) || !from.ForceBeSwitchList.Equal(to.ForceBeSwitchList) || !from.SSLFrontUses.Equal(to.SSLFrontUses) {
+		return c.EditStructuredFrontend(name, to, transactionID, 0)
+	}
+	if !from.FrontendBase.Equal(to.FrontendBase) {
+		if err := c.EditFrontend(name, &models.Frontend{FrontendBase: to.FrontendBase}, transactionID, 0); err != nil {
+			return err
+		}
+	}
+	for bindName := range from.Binds {
+		if _, ok := to.Binds[bindName]; !ok {
+			if err := c.DeleteBind(bindName, FrontendParentName, name, transactionID, 0); err != nil {
+				return err
+			}
+		}
+	}
+	for _, bindName := range slices.Sorted(maps.Keys(to.Binds)) {
+		bind := to.Binds[bindName]
+		previous, ok := from.Binds[bindName]
+		switch {
+		case !ok:
+			if err := c.CreateBind(FrontendParentName, name, &bind, transactionID, 0); err != nil {
+				return err
+			}
+		case !previous.Equal(bind):
+			if err := c.EditBind(bindName, FrontendParentName, name, &bind, transactionID, 0); err != nil {
+				return err
+			}
+		}
+	}
+	replace := []struct {
+		changed bool
+		replace func() error
+	}{
+		{!from.ACLList.Equal(to.ACLList), func() error {
+			return c.ReplaceAcls(FrontendParentName, name, to.ACLList, transactionID, 0)
+		}},
+		{!from.BackendSwitchingRuleList.Equal(to.BackendSwitchingRuleList), func() error {
+			return c.ReplaceBackendSwitchingRules(name, to.BackendSwitchingRuleList, transactionID, 0)
+		}},
+		{!from.CaptureList.Equal(to.CaptureList), func() error {
+			return c.ReplaceDeclareCaptures(name, to.CaptureList, transactionID, 0)
+		}},
+		{!from.FilterList.Equal(to.FilterList), func() error {
+			return c.ReplaceFilters(FrontendParentName, name, to.FilterList, transactionID, 0)
+		}},
+		{!from.HTTPAfterResponseRuleList.Equal(to.HTTPAfterResponseRuleList), func() error {
+			return c.ReplaceHTTPAfterResponseRules(FrontendParentName, name, to.HTTPAfterResponseRuleList, transactionID, 0)
+		}},
+		{!from.HTTPErrorRuleList.Equal(to.HTTPErrorRuleList), func() error {
+			return c.ReplaceHTTPErrorRules(FrontendParentName, name, to.HTTPErrorRuleList, transactionID, 0)
+		}},
+		{!from.HTTPRequestRuleList.Equal(to.HTTPRequestRuleList), func() error {
+			return c.ReplaceHTTPRequestRules(FrontendParentName, name, to.HTTPRequestRuleList, transactionID, 0)
+		}},
+		{!from.HTTPResponseRuleList.Equal(to.HTTPResponseRuleList), func() error {
+			return c.ReplaceHTTPResponseRules(FrontendParentName, name, to.HTTPResponseRuleList, transactionID, 0)
+		}},
+		{!from.LogTargetList.Equal(to.LogTargetList), func() error {
+			return c.ReplaceLogTargets(FrontendParentName, name, to.LogTargetList, transactionID, 0)
+		}},
+		{!from.QUICInitialRuleList.Equal(to.QUICInitialRuleList), func() error {
+			return c.ReplaceQUICInitialRules(FrontendParentName, name, to.QUICInitialRuleList, transactionID, 0)
+		}},
+		{!from.TCPRequestRuleList.Equal(to.TCPRequestRuleList), func() error {
+			return c.ReplaceTCPRequestRules(FrontendParentName, name, to.TCPRequestRuleList, transactionID, 0)
+		}},
+	}
+	for _, r := range replace {
+		if !r.changed {
+			continue
+		}
+		if err := r.replace(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyBackendEdit changes a backend from one state to another, only the changed attributes and
+// children being written so that the comments of the section are kept
+func (c *client) applyBackendEdit(from, to *models.Backend, transactionID string) error { //nolint:gocognit,gocyclo,cyclop
+	name := to.Name
+	// lists which can only be set with the whole section
+	if !from.FilterSequenceList.Equal(to.FilterSequenceList) {
+		return c.EditStructuredBackend(name, to, transactionID, 0)
+	}
+	if !from.BackendBase.Equal(to.BackendBase) {
+		if err := c.EditBackend(name, &models.Backend{BackendBase: to.BackendBase}, transactionID, 0); err != nil {
+			return err
+		}
+	}
+	for serverName := range from.Servers {
+		if _, ok := to.Servers[serverName]; !ok {
+			if err := c.DeleteServer(serverName, BackendParentName, name, transactionID, 0); err != nil {
+				return err
+			}
+		}
+	}
+	for _, serverName := range slices.Sorted(maps.Keys(to.Servers)) {
+		server := to.Servers[serverName]
+		previous, ok := from.Servers[serverName]
+		switch {
+		case !ok:
+			if err := c.CreateServer(BackendParentName, name, &server, transactionID, 0); err != nil {
+				return err
+			}
+		case !previous.Equal(server):
+			if err := c.EditServer(serverName, BackendParentName, name, &server, transactionID, 0); err != nil {
+				return err
+			}
+		}
+	}
+	for prefix := range from.ServerTemplates {
+		if _, ok := to.ServerTemplates[prefix]; !ok {
+			if err := c.DeleteServerTemplate(prefix, name, transactionID, 0); err != nil {
+				return err
+			}
+		}
+	}
+	for _, prefix := range slices.Sorted(maps.Keys(to.ServerTemplates)) {
+		template := to.ServerTemplates[prefix]
+		previous, ok := from.ServerTemplates[prefix]
+		switch {
+		case !ok:
+			if err := c.CreateServerTemplate(name, &template, transactionID, 0); err != nil {
+				return err
+			}
+		case !previous.Equal(template):
+			if err := c.EditServerTemplate(prefix, name, &template, transactionID, 0); err != nil {
+				return err
+			}
+		}
+	}
+	replace := []struct {
+		changed bool
+		replace func() error
+	}{
+		{!from.ACLList.Equal(to.ACLList), func() error {
+			return c.ReplaceAcls(BackendParentName, name, to.ACLList, transactionID, 0)
+		}},
+		{!from.FilterList.Equal(to.FilterList), func() error {
+			return c.ReplaceFilters(BackendParentName, name, to.FilterList, transactionID, 0)
+		}},
+		{!from.HTTPAfterResponseRuleList.Equal(to.HTTPAfterResponseRuleList), func() error {
+			return c.ReplaceHTTPAfterResponseRules(BackendParentName, name, to.HTTPAfterResponseRuleList, transactionID, 0)
+		}},
+		{!from.HTTPCheckList.Equal(to.HTTPCheckList), func() error {
+			return c.ReplaceHTTPChecks(BackendParentName, name, to.HTTPCheckList, transactionID, 0)
+		}},
+		{!from.HTTPErrorRuleList.Equal(to.HTTPErrorRuleList), func() error {
+			return c.ReplaceHTTPErrorRules(BackendParentName, name, to.HTTPErrorRuleList, transactionID, 0)
+		}},
+		{!from.HTTPRequestRuleList.Equal(to.HTTPRequestRuleList), func() error {
+			return c.ReplaceHTTPRequestRules(BackendParentName, name, to.HTTPRequestRuleList, transactionID, 0)
+		}},
+		{!from.HTTPResponseRuleList.Equal(to.HTTPResponseRuleList), func() error {
+			return c.ReplaceHTTPResponseRules(BackendParentName, name, to.HTTPResponseRuleList, transactionID, 0)
+		}},
+		{!from.LogTargetList.Equal(to.LogTargetList), func() error {
+			return c.ReplaceLogTargets(BackendParentName, name, to.LogTargetList, transactionID, 0)
+		}},
+		{!from.ServerSwitchingRuleList.Equal(to.ServerSwitchingRuleList), func() error {
+			return c.ReplaceServerSwitchingRules(name, to.ServerSwitchingRuleList, transactionID, 0)
+		}},
+		{!from.StickRuleList.Equal(to.StickRuleList), func() error {
+			return c.ReplaceStickRules(name, to.StickRuleList, transactionID, 0)
+		}},
+		{!from.TCPCheckRuleList.Equal(to.TCPCheckRuleList), func() error {
+			return c.ReplaceTCPChecks(BackendParentName, name, to.TCPCheckRuleList, transactionID, 0)
+		}},
+		{!from.TCPRequestRuleList.Equal(to.TCPRequestRuleList), func() error {
+			return c.ReplaceTCPRequestRules(BackendParentName, name, to.TCPRequestRuleList, transactionID, 0)
+		}},
+		{!from.TCPResponseRuleList.Equal(to.TCPResponseRuleList), func() error {
+			return c.ReplaceTCPResponseRules(BackendParentName, name, to.TCPResponseRuleList, transactionID, 0)
+		}},
+	}
+	for _, r := range replace {
+		if !r.changed {
+			continue
+		}
+		if err := r.replace(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
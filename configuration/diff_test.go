@@ -0,0 +1,151 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+	"github.com/haproxytech/client-native/v6/configuration/options"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+const diffTestConfig = `# _version=2
+global
+  daemon
+
+defaults unnamed_defaults_1
+  mode http
+
+backend be_app
+  mode http
+  balance roundrobin
+
+backend be_old
+  mode http
+
+backend be_static
+  mode http
+`
+
+func newDiffTestClient(t *testing.T, opts ...options.ConfigurationOption) (Configuration, string) {
+	t.Helper()
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, "haproxy.cfg")
+	if err := os.WriteFile(cfgFile, []byte(diffTestConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c, err := New(context.Background(), append([]options.ConfigurationOption{
+		options.ConfigurationFile(cfgFile),
+		options.TransactionsDir(filepath.Join(dir, "transactions")),
+		options.HAProxyVersion("3.1"),
+		options.SkipConfigurationFileValidation,
+	}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, cfgFile
+}
+
+func TestDiffConfiguration(t *testing.T) {
+	c, _ := newDiffTestClient(t)
+
+	tx, err := c.StartTransaction(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.EditBackend("be_app", &models.Backend{BackendBase: models.BackendBase{Name: "be_app", Mode: "tcp"}}, tx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.DeleteBackend("be_old", tx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.CreateFrontend(&models.Frontend{FrontendBase: models.FrontendBase{Name: "fe_web", DefaultBackend: "be_app"}}, tx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := c.DiffConfiguration(ConfigurationRef{}, ConfigurationRef{TransactionID: tx.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.FromVersion != 2 || diff.ToVersion != 2 {
+		t.Errorf("versions = %d -> %d, want 2 -> 2", diff.FromVersion, diff.ToVersion)
+	}
+	want := []struct {
+		changeType ChangeType
+		section    parser.Section
+		name       string
+	}{
+		{ChangeAdded, parser.Frontends, "fe_web"},
+		{ChangeModified, parser.Backends, "be_app"},
+		{ChangeRemoved, parser.Backends, "be_old"},
+	}
+	if len(diff.Changes) != len(want) {
+		t.Fatalf("got %d changes, want %d: %+v", len(diff.Changes), len(want), diff.Changes)
+	}
+	for i, w := range want {
+		got := diff.Changes[i]
+		if got.Type != w.changeType || got.Section != w.section || got.Name != w.name {
+			t.Errorf("change %d = %s %s %s, want %s %s %s", i, got.Type, got.Section, got.Name, w.changeType, w.section, w.name)
+		}
+	}
+	modified := diff.Changes[1]
+	if mode, ok := modified.Fields["BackendBase.Mode"]; !ok || mode[0] != "http" || mode[1] != "tcp" {
+		t.Errorf("BackendBase.Mode diff = %v, want [http tcp]", modified.Fields["BackendBase.Mode"])
+	}
+	if _, ok := modified.Fields["BackendBase.Balance"]; !ok {
+		t.Errorf("missing BackendBase.Balance diff in %v", modified.Fields)
+	}
+
+	diff, err = c.DiffConfiguration(ConfigurationRef{TransactionID: tx.ID}, ConfigurationRef{TransactionID: tx.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.Empty() {
+		t.Errorf("diff of a transaction with itself = %+v, want empty", diff.Changes)
+	}
+}
+
+func TestDiffConfiguration_Version(t *testing.T) {
+	c, cfgFile := newDiffTestClient(t)
+
+	backup := "# _version=1\nglobal\n  daemon\n\ndefaults unnamed_defaults_1\n  mode http\n\nbackend be_app\n  mode http\n  balance roundrobin\n\nbackend be_static\n  mode http\n"
+	if err := os.WriteFile(cfgFile+".1", []byte(backup), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := c.DiffConfiguration(ConfigurationRef{Version: 1}, ConfigurationRef{Version: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.FromVersion != 1 || diff.ToVersion != 2 {
+		t.Errorf("versions = %d -> %d, want 1 -> 2", diff.FromVersion, diff.ToVersion)
+	}
+	if len(diff.Changes) != 1 || diff.Changes[0].Type != ChangeAdded || diff.Changes[0].Name != "be_old" {
+		t.Errorf("changes = %+v, want be_old added", diff.Changes)
+	}
+
+	if _, err = c.DiffConfiguration(ConfigurationRef{Version: 5}, ConfigurationRef{}); !errors.Is(err, ErrObjectDoesNotExist) {
+		t.Errorf("DiffConfiguration() with missing backup error = %v, want ErrObjectDoesNotExist", err)
+	}
+	if _, err = c.DiffConfiguration(ConfigurationRef{TransactionID: "missing"}, ConfigurationRef{}); !errors.Is(err, ErrTransactionDoesNotExist) {
+		t.Errorf("DiffConfiguration() with missing transaction error = %v, want ErrTransactionDoesNotExist", err)
+	}
+}
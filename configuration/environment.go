@@ -0,0 +1,189 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+	"github.com/haproxytech/client-native/v6/misc"
+)
+
+type Environment interface {
+	// GetEnvironment returns the environment variables the configuration is expanded with.
+	GetEnvironment(transactionID string) (map[string]string, error)
+	// ExpandValue returns a raw value of the configuration with its environment variables expanded.
+	ExpandValue(value string, transactionID string) (string, error)
+	// GetEffectiveConfiguration returns a read-only view of the configuration with its environment variables expanded.
+	GetEffectiveConfiguration(transactionID string) (Configuration, error)
+}
+
+// GetEnvironment returns the environment variables the configuration is expanded with: the environment
+// given with the ExpandEnvironment option, or the environment of the process, changed by the resetenv,
+// unsetenv, presetenv and setenv of the global section, in this order
+func (c *client) GetEnvironment(transactionID string) (map[string]string, error) {
+	p, err := c.GetParser(transactionID)
+	if err != nil {
+		return nil, err
+	}
+	return c.environment(p)
+}
+
+// ExpandValue returns a raw value of the configuration, as returned in the models, with its
+// environment variables expanded. As HAProxy does, only variables within double quotes are expanded,
+// the quotes are removed when the value doesn't need them anymore.
+func (c *client) ExpandValue(value string, transactionID string) (string, error) {
+	env, err := c.GetEnvironment(transactionID)
+	if err != nil {
+		return "", err
+	}
+	return misc.ExpandEnvironmentUnquoted(value, lookupFunc(env)), nil
+}
+
+// GetEffectiveConfiguration returns a read-only view of the configuration with its environment variables
+// expanded. Its models hold the values HAProxy uses, the models of the configuration the raw ones.
+func (c *client) GetEffectiveConfiguration(transactionID string) (Configuration, error) {
+	p, err := c.GetParser(transactionID)
+	if err != nil {
+		return nil, err
+	}
+	env, err := c.environment(p)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(p.String(), "\n")
+	for i, line := range lines {
+		lines[i] = misc.ExpandEnvironmentUnquoted(line, lookupFunc(env))
+	}
+	effective, err := c.snapshot(strings.Join(lines, "\n"))
+	if err != nil {
+		return nil, err
+	}
+	effective.readOnly = true
+	effective.TransactionClient = effective
+	return effective, nil
+}
+
+func (c *client) environment(p parser.Parser) (map[string]string, error) {
+	env := map[string]string{}
+	if c.Environment != nil {
+		maps.Copy(env, c.Environment)
+	} else {
+		for _, variable := range os.Environ() {
+			name, value, _ := strings.Cut(variable, "=")
+			env[name] = value
+		}
+	}
+
+	options, err := parseEnvironmentOptions(p)
+	if err != nil || options == nil {
+		return env, err
+	}
+	if options.Resetenv != "" {
+		keep := strings.Fields(options.Resetenv)
+		maps.DeleteFunc(env, func(name, _ string) bool {
+			return !slices.Contains(keep, name)
+		})
+	}
+	for _, name := range strings.Fields(options.Unsetenv) {
+		delete(env, name)
+	}
+	for _, e := range options.PresetEnvs {
+		if _, ok := env[*e.Name]; !ok {
+			env[*e.Name] = unquote(misc.ExpandEnvironment(*e.Value, lookupFunc(env)))
+		}
+	}
+	for _, e := range options.SetEnvs {
+		env[*e.Name] = unquote(misc.ExpandEnvironment(*e.Value, lookupFunc(env)))
+	}
+	return env, nil
+}
+
+func lookupFunc(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+}
+
+// unquote removes the double quotes around a value
+func unquote(value string) string {
+	if len(value) > 1 && value[0] == '"' && value[len(value)-1] == '"' {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+type validatable interface {
+	Validate(formats strfmt.Registry) error
+}
+
+// validateModel validates a model. When the environment is expanded, the model is validated
+// with its values expanded with the environment of the transaction, references like "${PORT}"
+// failing the patterns of the fields.
+func (c *client) validateModel(data validatable, transactionID string) error {
+	if !c.ExpandEnvironment {
+		return data.Validate(strfmt.Default)
+	}
+	env, err := c.GetEnvironment(transactionID)
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	// keep large integers as they are
+	decoder.UseNumber()
+	var values any
+	if err = decoder.Decode(&values); err != nil {
+		return err
+	}
+	if raw, err = json.Marshal(expandValues(values, lookupFunc(env))); err != nil {
+		return err
+	}
+	expanded := reflect.New(reflect.TypeOf(data))
+	if err = json.Unmarshal(raw, expanded.Interface()); err != nil {
+		return err
+	}
+	return expanded.Elem().Interface().(validatable).Validate(strfmt.Default) //nolint:forcetypeassert
+}
+
+// expandValues expands the environment variables of the strings of a decoded JSON value
+func expandValues(value any, lookup func(string) (string, bool)) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = expandValues(item, lookup)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = expandValues(item, lookup)
+		}
+	case string:
+		return misc.ExpandEnvironmentUnquoted(v, lookup)
+	}
+	return value
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/configuration/options"
+	"github.com/haproxytech/client-native/v6/misc"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+const environmentTestConfig = `# _version=1
+global
+  daemon
+  presetenv TIMEOUT 5s
+  presetenv LOG_LEVEL info
+  setenv BACKEND_IP "${BASE}.10"
+
+defaults unnamed_defaults_1
+  mode http
+  timeout connect "${TIMEOUT}"
+
+frontend fe
+  bind "${BIND_ADDR:-127.0.0.1}:8080" name main
+
+backend be
+  server s1 "${BACKEND_IP}":80
+`
+
+func newEnvironmentTestClient(t *testing.T, cfgFile string, opts ...options.ConfigurationOption) Configuration {
+	t.Helper()
+	if err := os.WriteFile(cfgFile, []byte(environmentTestConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c, err := New(context.Background(), append([]options.ConfigurationOption{
+		options.ConfigurationFile(cfgFile),
+		options.TransactionsDir(filepath.Join(filepath.Dir(cfgFile), "transactions")),
+		options.HAProxyVersion("3.1"),
+		options.SkipConfigurationFileValidation,
+		options.UseModelsValidation,
+	}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestEnvironment(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "haproxy.cfg")
+	c := newEnvironmentTestClient(t, cfgFile, options.ExpandEnvironment(map[string]string{
+		"BASE":    "10.0.0",
+		"TIMEOUT": "2s",
+		"GUID":    "fe-main",
+	}))
+
+	env, err := c.GetEnvironment("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{"TIMEOUT": "2s", "LOG_LEVEL": "info", "BACKEND_IP": "10.0.0.10"} {
+		if env[name] != want {
+			t.Errorf("%s = %q, want %q", name, env[name], want)
+		}
+	}
+	value, err := c.ExpandValue(`"${BIND_ADDR:-127.0.0.1}:8080"`, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "127.0.0.1:8080" {
+		t.Errorf("ExpandValue() = %s, want 127.0.0.1:8080", value)
+	}
+
+	effective, err := c.GetEffectiveConfiguration("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, raw, err := c.GetServer("s1", BackendParentName, "be", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, server, err := effective.GetServer("s1", BackendParentName, "be", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if raw.Address != `"${BACKEND_IP}"` || server.Address != "10.0.0.10" {
+		t.Errorf("server address = %s, effective %s, want \"${BACKEND_IP}\" and 10.0.0.10", raw.Address, server.Address)
+	}
+	_, defaults, err := effective.GetDefaultsSection("unnamed_defaults_1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if defaults.ConnectTimeout == nil || *defaults.ConnectTimeout != 2000 {
+		t.Errorf("effective connect timeout = %v, want 2000", defaults.ConnectTimeout)
+	}
+	if err = effective.DeleteServer("s1", BackendParentName, "be", "", 1); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("DeleteServer() of the effective configuration error = %v, want ErrReadOnly", err)
+	}
+
+	// variables are validated expanded and saved as they are
+	bind := &models.Bind{
+		Name:       "guid",
+		Address:    `"${BIND_ADDR:-127.0.0.1}"`,
+		Port:       misc.Int64P(8443),
+		BindParams: models.BindParams{GUIDPrefix: `"${GUID}"`},
+	}
+	if err = c.CreateBind(FrontendParentName, "fe", bind, "", 1); err != nil {
+		t.Fatal(err)
+	}
+	if data := readTestFile(t, cfgFile); !strings.Contains(data, `bind "${BIND_ADDR:-127.0.0.1}":8443 name guid guid-prefix "${GUID}"`) {
+		t.Errorf("configuration file does not have the unexpanded bind:\n%s", data)
+	}
+}
+
+func TestEnvironment_VariableTimeout(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "haproxy.cfg")
+	c := newEnvironmentTestClient(t, cfgFile, options.ExpandEnvironment(map[string]string{"TIMEOUT": "2s"}))
+
+	_, defaults, err := c.GetDefaultsSection("unnamed_defaults_1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if defaults.ConnectTimeout != nil {
+		t.Fatalf("connect timeout = %d, want nil", *defaults.ConnectTimeout)
+	}
+	if err = c.EditDefaultsSection("unnamed_defaults_1", defaults, "", 1); err != nil {
+		t.Fatal(err)
+	}
+	if data := readTestFile(t, cfgFile); !strings.Contains(data, `timeout connect "${TIMEOUT}"`) {
+		t.Errorf("configuration file does not have the variable timeout:\n%s", data)
+	}
+
+	// a timeout set in the model replaces the variable
+	defaults.ConnectTimeout = misc.Int64P(3000)
+	if err = c.EditDefaultsSection("unnamed_defaults_1", defaults, "", 2); err != nil {
+		t.Fatal(err)
+	}
+	if data := readTestFile(t, cfgFile); !strings.Contains(data, "timeout connect 3000") {
+		t.Errorf("configuration file does not have the edited timeout:\n%s", data)
+	}
+}
+
+func TestEnvironment_Validation(t *testing.T) {
+	c := newEnvironmentTestClient(t, filepath.Join(t.TempDir(), "haproxy.cfg"))
+	bind := &models.Bind{
+		Name:       "guid",
+		Address:    "127.0.0.1",
+		BindParams: models.BindParams{GUIDPrefix: `"${GUID}"`},
+	}
+	if err := c.CreateBind(FrontendParentName, "fe", bind, "", 1); !errors.Is(err, ErrValidationError) {
+		t.Errorf("CreateBind() without expansion error = %v, want ErrValidationError", err)
+	}
+}
+
+func TestEnvironment_ValidationInTransaction(t *testing.T) {
+	c := newEnvironmentTestClient(t, filepath.Join(t.TempDir(), "haproxy.cfg"), options.ExpandEnvironment(map[string]string{
+		"BASE": "10.0.0",
+	}))
+	tx, err := c.StartTransaction(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, global, err := c.GetGlobalConfiguration(tx.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	global.EnvironmentOptions.SetEnvs = append(global.EnvironmentOptions.SetEnvs, &models.SetEnv{Name: misc.StringP("GUID"), Value: misc.StringP("fe-main")})
+	if err = c.PushGlobalConfiguration(global, tx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// GUID is only set in the transaction
+	bind := &models.Bind{
+		Name:       "guid",
+		Address:    "127.0.0.1",
+		BindParams: models.BindParams{GUIDPrefix: `"${GUID}"`},
+	}
+	if err = c.CreateBind(FrontendParentName, "fe", bind, tx.ID, 0); err != nil {
+		t.Errorf("CreateBind() in the transaction error = %v", err)
+	}
+	if err = c.CreateBind(FrontendParentName, "fe", bind, "", 1); !errors.Is(err, ErrValidationError) {
+		t.Errorf("CreateBind() outside the transaction error = %v, want ErrValidationError", err)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	oaerrors "github.com/go-openapi/errors"
+	"github.com/haproxytech/client-native/v6/diagnostics"
 )
 
 var (
@@ -37,6 +38,8 @@ var (
 	ErrTransactionDoesNotExist  = errors.New("transaction does not exist")
 	ErrTransactionAlreadyExists = errors.New("transaction already exist")
 	ErrCannotParseTransaction   = errors.New("failed to parse transaction")
+	ErrRebaseConflict           = errors.New("transaction conflicts with the configuration")
+	ErrReadOnly                 = errors.New("configuration is read-only")
 
 	ErrObjectDoesNotExist    = errors.New("missing object")
 	ErrObjectAlreadyExists   = errors.New("object already exists")
@@ -48,20 +51,28 @@ var (
 	ErrCannotSetVersion    = errors.New("cannot set version")
 
 	ErrCannotFindHAProxy = errors.New("failed to find HAProxy")
+	ErrUnsupported       = errors.New("not supported by HAProxy version")
 
 	ErrClientDoesNotExists = errors.New("client does not exist")
 )
 
 // ConfError general configuration client error
 type ConfError struct {
-	err    error
-	reason string // optional
+	err         error
+	reason      string                  // optional
+	diagnostics diagnostics.Diagnostics // optional
+	cause       error                   // optional
 }
 
 func (e *ConfError) Err() error {
 	return e.err
 }
 
+// Diagnostics returns the messages of HAProxy when the error comes from a configuration check
+func (e *ConfError) Diagnostics() diagnostics.Diagnostics {
+	return e.diagnostics
+}
+
 // Error implementation for ConfError
 func (e *ConfError) Error() string {
 	if e.reason == "" {
@@ -74,6 +85,11 @@ func (e *ConfError) Is(target error) bool {
 	return e.err == target
 }
 
+// Unwrap returns the error the ConfError was created from, if any
+func (e *ConfError) Unwrap() error {
+	return e.cause
+}
+
 // NewConfError constructor for ConfError
 func NewConfError(err error, reason string) *ConfError {
 	return &ConfError{err: err, reason: reason}
@@ -0,0 +1,219 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+	parser_options "github.com/haproxytech/client-native/v6/config-parser/options"
+)
+
+type Files interface {
+	// GetConfigurationFiles returns the configuration files in load order, the main configuration file first.
+	GetConfigurationFiles(transactionID string) ([]string, error)
+	// GetSectionFile returns the configuration file a section belongs to.
+	GetSectionFile(section parser.Section, name string, transactionID string) (string, error)
+	// SetSectionFile moves a section to another configuration file. One of version or transactionID is mandatory.
+	SetSectionFile(section parser.Section, name string, file string, transactionID string, version int64) error
+}
+
+// GetConfigurationFiles returns the configuration files in load order, the main configuration file first
+func (c *client) GetConfigurationFiles(transactionID string) ([]string, error) {
+	p, err := c.GetParser(transactionID)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{c.ConfigurationFile}, p.Files()...), nil
+}
+
+// GetSectionFile returns the configuration file a section was read from or moved to
+func (c *client) GetSectionFile(section parser.Section, name string, transactionID string) (string, error) {
+	p, err := c.GetParser(transactionID)
+	if err != nil {
+		return "", err
+	}
+	file, err := p.SectionFileGet(section, name)
+	if err != nil {
+		return "", c.HandleError(name, "", "", "", false, err)
+	}
+	if file == "" {
+		return c.ConfigurationFile, nil
+	}
+	return file, nil
+}
+
+// SetSectionFile moves a section to another configuration file: the main configuration file, one of the
+// loaded files or a new .cfg file in one of the loaded directories. New sections are written to the main
+// configuration file, moving them in the transaction creating them chooses their file.
+// One of version or transactionID is mandatory.
+func (c *client) SetSectionFile(section parser.Section, name string, file string, transactionID string, version int64) error {
+	p, t, err := c.loadDataForChange(transactionID, version)
+	if err != nil {
+		return err
+	}
+
+	file = filepath.Clean(file)
+	if file == filepath.Clean(c.ConfigurationFile) {
+		file = ""
+	} else if !c.isConfigurationFile(file) {
+		e := NewConfError(ErrValidationError, fmt.Sprintf("%s is not a configuration file", file))
+		return c.HandleError(name, "", "", t, transactionID == "", e)
+	}
+
+	if err = p.SectionFileSet(section, name, file); err != nil {
+		return c.HandleError(name, "", "", t, transactionID == "", err)
+	}
+
+	return c.SaveData(p, t, transactionID == "")
+}
+
+// isConfigurationFile returns true if file is one of the configuration files or a .cfg file
+// in one of the configuration directories
+func (c *client) isConfigurationFile(file string) bool {
+	file = filepath.Clean(file)
+	for _, path := range c.ConfigurationFiles {
+		path = filepath.Clean(path)
+		if path == file {
+			return true
+		}
+		if filepath.Ext(file) != ".cfg" || filepath.Dir(file) != path {
+			continue
+		}
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			return true
+		}
+	}
+	return false
+}
+
+// checkConfigurationFiles returns an error if sections of a parser belong to files which are not
+// configuration files, as set with the file markers of a raw configuration
+func (c *client) checkConfigurationFiles(p parser.Parser) error {
+	for _, file := range p.Files() {
+		if !c.isConfigurationFile(file) {
+			return NewConfError(ErrValidationError, fmt.Sprintf("%s is not a configuration file", file))
+		}
+	}
+	return nil
+}
+
+// configurationFiles returns the files to load after the main configuration file, with the
+// .cfg files of the directories in lexical order as HAProxy does
+func (c *client) configurationFiles() ([]string, error) {
+	files := []string{}
+	for _, path := range c.ConfigurationFiles {
+		path = filepath.Clean(path)
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, NewConfError(ErrCannotReadConfFile, err.Error())
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, NewConfError(ErrCannotReadConfFile, err.Error())
+		}
+		dirFiles := []string{}
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != ".cfg" {
+				continue
+			}
+			dirFiles = append(dirFiles, filepath.Join(path, entry.Name()))
+		}
+		sort.Strings(dirFiles)
+		files = append(files, dirFiles...)
+	}
+	return files, nil
+}
+
+// readConfiguration returns the configuration files read as one, the sections of each
+// file after the main one introduced by a file marker
+func (c *client) readConfiguration() (string, error) {
+	files, err := c.configurationFiles()
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(c.ConfigurationFile)
+	if err != nil {
+		return "", NewConfError(ErrCannotReadConfFile, err.Error())
+	}
+	var result strings.Builder
+	result.Write(data)
+	for _, file := range files {
+		data, err = os.ReadFile(file)
+		if err != nil {
+			return "", NewConfError(ErrCannotReadConfFile, err.Error())
+		}
+		result.WriteString("\n" + parser.FileMarker + file + "\n")
+		result.Write(data)
+	}
+	return result.String(), nil
+}
+
+// configurationSource returns the parser option reading the configuration file,
+// or all the configuration files when there are several
+func (c *client) configurationSource() (parser_options.ParserOption, error) {
+	if len(c.ConfigurationFiles) == 0 {
+		return parser_options.Path(c.ConfigurationFile), nil
+	}
+	data, err := c.readConfiguration()
+	if err != nil {
+		return nil, err
+	}
+	return parser_options.String(data), nil
+}
+
+// renderedFile is the content of a configuration file as written by a parser
+type renderedFile struct {
+	path string
+	data string
+}
+
+// renderFiles returns the configuration files as a transaction writes them, the main configuration file first
+func (c *client) renderFiles(transactionID string) ([]renderedFile, error) {
+	p, err := c.GetParser(transactionID)
+	if err != nil {
+		return nil, err
+	}
+	files := []renderedFile{{path: c.ConfigurationFile, data: p.FileString("")}}
+	for _, file := range p.Files() {
+		files = append(files, renderedFile{path: file, data: p.FileString(file)})
+	}
+	return files, nil
+}
+
+// saveConfiguration writes the configuration of a parser to the configuration files
+func (c *client) saveConfiguration(p parser.Parser) error {
+	if len(c.ConfigurationFiles) == 0 {
+		return p.Save(c.ConfigurationFile)
+	}
+	if err := c.checkConfigurationFiles(p); err != nil {
+		return err
+	}
+	// the files loaded which have no section anymore are emptied
+	loaded, err := c.configurationFiles()
+	if err != nil {
+		return err
+	}
+	return p.SaveFiles(c.ConfigurationFile, loaded...)
+}
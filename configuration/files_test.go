@@ -0,0 +1,217 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+	"github.com/haproxytech/client-native/v6/configuration/options"
+	"github.com/haproxytech/client-native/v6/misc"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+func newFilesTestClient(t *testing.T, cfgFile, confDir string, opts ...options.ConfigurationOption) Configuration {
+	t.Helper()
+	c, err := New(context.Background(), append([]options.ConfigurationOption{
+		options.ConfigurationFile(cfgFile),
+		options.ConfigurationFiles(confDir),
+		options.TransactionsDir(filepath.Join(filepath.Dir(cfgFile), "transactions")),
+		options.HAProxyVersion("3.1"),
+		options.SkipConfigurationFileValidation,
+	}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func readTestFile(t *testing.T, file string) string {
+	t.Helper()
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestConfigurationFiles(t *testing.T) {
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, "haproxy.cfg")
+	confDir := filepath.Join(dir, "conf.d")
+	files := map[string]string{
+		cfgFile:                              "# _version=1\nglobal\n  daemon\n\ndefaults unnamed_defaults_1\n  mode http\n\nbackend be_main\n  mode http\n",
+		filepath.Join(confDir, "20-b.cfg"):   "backend be_b\n  mode http\n",
+		filepath.Join(confDir, "10-a.cfg"):   "# team a\nbackend be_a\n  mode http\n",
+		filepath.Join(confDir, "readme.txt"): "not a configuration file\n",
+	}
+	if err := os.Mkdir(confDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for file, data := range files {
+		if err := os.WriteFile(file, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fileA := filepath.Join(confDir, "10-a.cfg")
+	fileB := filepath.Join(confDir, "20-b.cfg")
+	fileC := filepath.Join(confDir, "30-c.cfg")
+
+	c := newFilesTestClient(t, cfgFile, confDir, options.UsePersistentTransactions)
+
+	loaded, err := c.GetConfigurationFiles("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(loaded, []string{cfgFile, fileA, fileB}) {
+		t.Errorf("configuration files = %v, want %v", loaded, []string{cfgFile, fileA, fileB})
+	}
+	for name, want := range map[string]string{"be_main": cfgFile, "be_a": fileA, "be_b": fileB} {
+		file, err := c.GetSectionFile(parser.Backends, name, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if file != want {
+			t.Errorf("file of backend %s = %s, want %s", name, file, want)
+		}
+	}
+
+	if err = c.EditBackend("be_a", &models.Backend{BackendBase: models.BackendBase{Name: "be_a", Mode: "tcp"}}, "", 1); err != nil {
+		t.Fatal(err)
+	}
+	if data := readTestFile(t, fileA); !strings.Contains(data, "backend be_a") || !strings.Contains(data, "mode tcp") {
+		t.Errorf("%s after edit:\n%s\nwant be_a in mode tcp", fileA, data)
+	}
+	if data := readTestFile(t, cfgFile); strings.Contains(data, "be_a") || !strings.Contains(data, "# _version=2") {
+		t.Errorf("%s after edit:\n%s\nwant version 2 without be_a", cfgFile, data)
+	}
+
+	// structured edits replace the section, it must stay in its file
+	_, be, err := c.GetStructuredBackend("be_a", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	be.Balance = &models.Balance{Algorithm: misc.StringP("roundrobin")}
+	if err = c.EditStructuredBackend("be_a", be, "", 2); err != nil {
+		t.Fatal(err)
+	}
+	if data := readTestFile(t, fileA); !strings.Contains(data, "balance roundrobin") {
+		t.Errorf("%s after structured edit:\n%s\nwant balance roundrobin", fileA, data)
+	}
+
+	tx, err := c.StartTransaction(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.CreateBackend(&models.Backend{BackendBase: models.BackendBase{Name: "be_c", Mode: "http"}}, tx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.SetSectionFile(parser.Backends, "be_c", fileC, tx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.SetSectionFile(parser.Backends, "be_b", cfgFile, tx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	err = c.SetSectionFile(parser.Backends, "be_main", filepath.Join(dir, "other.cfg"), tx.ID, 0)
+	if !errors.Is(err, ErrValidationError) {
+		t.Errorf("SetSectionFile() outside of the configuration directories error = %v, want ErrValidationError", err)
+	}
+
+	// each changed file has its own diff, against the file on disk
+	diff, err := c.GetTransactionDiff(tx.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{cfgFile, fileB, fileC} {
+		if !strings.Contains(diff, "--- "+file+"\n") {
+			t.Errorf("transaction diff without %s:\n%s", file, diff)
+		}
+	}
+	if strings.Contains(diff, fileA) || !strings.Contains(diff, "-backend be_b") || !strings.Contains(diff, "+backend be_c") {
+		t.Errorf("unexpected transaction diff:\n%s", diff)
+	}
+
+	if _, err = c.CommitTransaction(tx.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if data := readTestFile(t, fileC); !strings.Contains(data, "backend be_c") {
+		t.Errorf("%s:\n%s\nwant be_c", fileC, data)
+	}
+	if data := readTestFile(t, fileB); data != "" {
+		t.Errorf("%s:\n%s\nwant empty", fileB, data)
+	}
+	if data := readTestFile(t, cfgFile); !strings.Contains(data, "backend be_b") || strings.Contains(data, "be_c") {
+		t.Errorf("%s:\n%s\nwant be_b without be_c", cfgFile, data)
+	}
+
+	// the raw configuration holds every file, posting it back leaves them as they are
+	version, raw, err := c.GetRawConfiguration("", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(raw, parser.FileMarker+fileC+"\n") || !strings.Contains(raw, "backend be_c") {
+		t.Errorf("raw configuration:\n%s\nwant the sections of %s", raw, fileC)
+	}
+	before := readTestFile(t, fileC)
+	if err = c.PostRawConfiguration(&raw, version, false); err != nil {
+		t.Fatal(err)
+	}
+	if data := readTestFile(t, fileC); data != before {
+		t.Errorf("%s after posting the raw configuration:\n%s\nwant:\n%s", fileC, data, before)
+	}
+
+	// the files are found again when reloading
+	c = newFilesTestClient(t, cfgFile, confDir, options.UsePersistentTransactions)
+	for name, want := range map[string]string{"be_a": fileA, "be_b": cfgFile, "be_c": fileC} {
+		file, err := c.GetSectionFile(parser.Backends, name, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if file != want {
+			t.Errorf("file of backend %s after reload = %s, want %s", name, file, want)
+		}
+	}
+
+	// file markers can only name configuration files
+	version, raw, err = c.GetRawConfiguration("", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outside := filepath.Join(dir, "outside", "evil.cfg")
+	evil := raw + "\n" + parser.FileMarker + outside + "\nbackend be_evil\n  mode http\n"
+	if err = c.PostRawConfiguration(&evil, version, false); !errors.Is(err, ErrValidationError) {
+		t.Errorf("PostRawConfiguration() with %s error = %v, want ErrValidationError", outside, err)
+	}
+	if _, err = os.Stat(outside); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("%s written: %v", outside, err)
+	}
+
+	// the files left out of a raw configuration are emptied
+	withoutA := raw[:strings.Index(raw, parser.FileMarker+fileA)] + raw[strings.Index(raw, parser.FileMarker+fileB):]
+	if err = c.PostRawConfiguration(&withoutA, version, false); err != nil {
+		t.Fatal(err)
+	}
+	if data := readTestFile(t, fileA); data != "" {
+		t.Errorf("%s after posting a raw configuration without it:\n%s\nwant empty", fileA, data)
+	}
+}
@@ -20,7 +20,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/parsers/filters"
@@ -119,7 +118,7 @@ func (c *client) DeleteFilter(id int64, parentType string, parentName string, tr
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateFilter(id int64, parentType string, parentName string, data *models.Filter, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -149,7 +148,7 @@ func (c *client) CreateFilter(id int64, parentType string, parentName string, da
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditFilter(id int64, parentType string, parentName string, data *models.Filter, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -180,7 +179,7 @@ func (c *client) EditFilter(id int64, parentType string, parentName string, data
 // Returns error on fail, nil on success.
 func (c *client) ReplaceFilters(parentType string, parentName string, data models.Filters, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -17,7 +17,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -92,7 +91,7 @@ func (c *client) DeleteForceBeSwitch(number int64, parentType string, parentName
 
 func (c *client) CreateForceBeSwitch(parentType string, parentName string, data *models.ForceBeSwitch, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -112,7 +111,7 @@ func (c *client) CreateForceBeSwitch(parentType string, parentName string, data
 
 func (c *client) EditForceBeSwitch(number int64, parentType string, parentName string, data *models.ForceBeSwitch, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
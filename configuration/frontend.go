@@ -18,7 +18,6 @@ package configuration
 import (
 	"fmt"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 
 	"github.com/haproxytech/client-native/v6/models"
@@ -97,7 +96,7 @@ func (c *client) DeleteFrontend(name string, transactionID string, version int64
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditFrontend(name string, data *models.Frontend, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -110,7 +109,7 @@ func (c *client) EditFrontend(name string, data *models.Frontend, transactionID
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateFrontend(data *models.Frontend, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
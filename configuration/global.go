@@ -22,7 +22,6 @@ import (
 
 	"github.com/haproxytech/client-native/v6/config-parser/parsers"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/common"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
@@ -82,7 +81,7 @@ func ValidateGlobalSection(data *models.Global) error {
 // config file
 func (c *client) PushGlobalConfiguration(data *models.Global, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
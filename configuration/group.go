@@ -20,7 +20,6 @@ import (
 	"fmt"
 	"strings"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -97,7 +96,7 @@ func (c *client) DeleteGroup(name string, userlist string, transactionID string,
 // Returns error on fail, nil on success
 func (c *client) CreateGroup(userlist string, data *models.Group, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -123,7 +122,7 @@ func (c *client) CreateGroup(userlist string, data *models.Group, transactionID
 // Returns error on fail, nil on success.
 func (c *client) EditGroup(name string, userlist string, data *models.Group, transactionID string, version int64) error { //nolint:revive
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
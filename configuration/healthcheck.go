@@ -19,7 +19,6 @@ import (
 	"errors"
 	"fmt"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parsererrors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -236,7 +235,7 @@ func (c *client) DeleteHealthcheck(name string, transactionID string, version in
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateHealthcheck(data *models.HealthCheck, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -266,7 +265,7 @@ func (c *client) CreateHealthcheck(data *models.HealthCheck, transactionID strin
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditHealthcheck(name string, data *models.HealthCheck, transactionID string, version int64) error { //nolint:revive
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -20,7 +20,6 @@ import (
 	"strconv"
 	"strings"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/common"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
@@ -131,7 +130,7 @@ func (c *client) DeleteHTTPCheck(id int64, parentType string, parentName string,
 // Returns error on fail, nil on success.
 func (c *client) CreateHTTPCheck(id int64, parentType string, parentName string, data *models.HTTPCheck, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -170,7 +169,7 @@ func (c *client) CreateHTTPCheck(id int64, parentType string, parentName string,
 // Returns error on fail, nil on success.
 func (c *client) EditHTTPCheck(id int64, parentType string, parentName string, data *models.HTTPCheck, transactionID string, version int64) error { //nolint:dupl
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -212,7 +211,7 @@ func (c *client) EditHTTPCheck(id int64, parentType string, parentName string, d
 // Returns error on fail, nil on success.
 func (c *client) ReplaceHTTPChecks(parentType string, parentName string, data models.HTTPChecks, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -20,7 +20,6 @@ import (
 	"fmt"
 	"strconv"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	http_actions "github.com/haproxytech/client-native/v6/config-parser/parsers/http/actions"
@@ -128,7 +127,7 @@ func (c *client) DeleteHTTPErrorRule(id int64, parentType string, parentName str
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateHTTPErrorRule(id int64, parentType string, parentName string, data *models.HTTPErrorRule, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -166,7 +165,7 @@ func (c *client) CreateHTTPErrorRule(id int64, parentType string, parentName str
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditHTTPErrorRule(id int64, parentType string, parentName string, data *models.HTTPErrorRule, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -210,7 +209,7 @@ func (c *client) EditHTTPErrorRule(id int64, parentType string, parentName strin
 // Returns error on fail, nil on success.
 func (c *client) ReplaceHTTPErrorRules(parentType string, parentName string, data models.HTTPErrorRules, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
 	"github.com/haproxytech/client-native/v6/misc"
@@ -96,7 +95,7 @@ func (c *client) DeleteHTTPErrorsSection(name, transactionID string, version int
 // CreateHTTPErrorsSection adds a new http-errors section.
 func (c *client) CreateHTTPErrorsSection(data *models.HTTPErrorsSection, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -127,7 +126,7 @@ func (c *client) CreateHTTPErrorsSection(data *models.HTTPErrorsSection, transac
 // EditHTTPErrorsSection replaces a single http-errors section with a given name.
 func (c *client) EditHTTPErrorsSection(name string, data *models.HTTPErrorsSection, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -17,13 +17,11 @@ package configuration
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"os/exec"
 	"path/filepath"
-	"strconv"
-	"strings"
 
+	"github.com/Masterminds/semver/v3"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_options "github.com/haproxytech/client-native/v6/config-parser/options"
 
@@ -80,13 +78,21 @@ type Configuration interface {
 	QUICInitialRule
 	TransactionHandling
 	Version
+	Capabilities
+	Diff
+	Rebase
+	Journal
+	Backups
+	Files
+	Environment
+	Declarative
 	Userlist
 	User
 	Group
 	Structured
 }
 
-func New(ctx context.Context, opt ...options.ConfigurationOption) (Configuration, error) { //nolint:revive
+func New(ctx context.Context, opt ...options.ConfigurationOption) (Configuration, error) {
 	c := &client{}
 	var err error
 
@@ -119,11 +125,11 @@ func New(ctx context.Context, opt ...options.ConfigurationOption) (Configuration
 		optionsWrapper.PreferredTimeSuffix = options.DefaultTimeSuffix
 	}
 
-	versionString, err := c.fetchVersion(optionsWrapper.Haproxy)
+	c.haproxyVersion, err = c.resolveHAProxyVersion(optionsWrapper)
 	if err != nil {
-		return nil, NewConfError(ErrCannotFindHAProxy, fmt.Sprintf("path to HAProxy binary not valid: %s, err: %s", optionsWrapper.Haproxy, err.Error()))
+		return nil, err
 	}
-	noNamedDefaultsFrom := noNamedDefaultsFrom(versionString)
+	noNamedDefaultsFrom := noNamedDefaultsFrom(c.haproxyVersion)
 	c.noNamedDefaultsFrom = true
 
 	c.TransactionClient = c
@@ -146,7 +152,11 @@ func New(ctx context.Context, opt ...options.ConfigurationOption) (Configuration
 		parserOptions = append(parserOptions, parser_options.NoNamedDefaultsFrom)
 	}
 
-	parserOptions = append(parserOptions, parser_options.Path(optionsWrapper.ConfigurationFile))
+	source, err := c.configurationSource()
+	if err != nil {
+		return nil, err
+	}
+	parserOptions = append(parserOptions, source)
 
 	p, err := parser.New(parserOptions...)
 	if err != nil {
@@ -155,6 +165,10 @@ func New(ctx context.Context, opt ...options.ConfigurationOption) (Configuration
 
 	c.parser = p
 
+	if c.TransactionTTL > 0 {
+		go c.sweepTransactions(ctx)
+	}
+
 	return c, nil
 }
 
@@ -162,50 +176,54 @@ func (c *client) Parser() parser.Parser {
 	return c.parser
 }
 
-//nolint:noctx
-func (c *client) fetchVersion(haproxy string) (string, error) {
-	versionString, err := exec.Command(haproxy, "-v").Output()
-	if err != nil {
-		return "", err
-	}
-	return string(versionString), nil
-}
-
-func getVersionNumbers(version string) (int64, int64, int64, error) {
-	if !strings.HasPrefix(version, "HAProxy version ") {
-		return 0, 0, 0, errors.New("not a haproxy version string")
-	}
-	version = version[strings.Index(version, "HAProxy version ")+len("HAProxy version "):]
-	versionSlice := strings.SplitN(version, "-", 2)
-	if len(versionSlice) != 2 {
-		return 0, 0, 0, errors.New("not a haproxy version string")
+// resolveHAProxyVersion returns the HAProxy version set in the options, or else the version
+// of the HAProxy binary. A missing binary is only an error when it is needed for validation,
+// the version is then unknown and nil is returned.
+func (c *client) resolveHAProxyVersion(opt options.ConfigurationOptions) (*semver.Version, error) {
+	if opt.HAProxyVersion != "" {
+		version, err := semver.NewVersion(opt.HAProxyVersion)
+		if err != nil {
+			return nil, NewConfError(ErrCannotReadVersion, fmt.Sprintf("invalid HAProxy version %s: %s", opt.HAProxyVersion, err.Error()))
+		}
+		return version, nil
 	}
-
-	versionInts := strings.SplitN(versionSlice[0], ".", 3)
-	if len(versionInts) != 3 {
-		return 0, 0, 0, errors.New("not a haproxy version string")
+	if opt.HAProxyVersionProvider != nil {
+		versionString, err := opt.HAProxyVersionProvider()
+		if err != nil {
+			return nil, NewConfError(ErrCannotReadVersion, "cannot get HAProxy version: "+err.Error())
+		}
+		version, err := semver.NewVersion(versionString)
+		if err != nil {
+			return nil, NewConfError(ErrCannotReadVersion, fmt.Sprintf("invalid HAProxy version %s: %s", versionString, err.Error()))
+		}
+		return version, nil
 	}
 
-	major, err := strconv.ParseInt(versionInts[0], 10, 64)
-	if err != nil {
-		return 0, 0, 0, errors.New("not a haproxy version string")
-	}
-	minor, err := strconv.ParseInt(versionInts[1], 10, 64)
+	versionString, err := c.fetchVersion(opt.Haproxy)
 	if err != nil {
-		return 0, 0, 0, errors.New("not a haproxy version string")
+		if opt.SkipConfigurationFileValidation || opt.ValidateCmd != "" {
+			return nil, nil //nolint:nilnil
+		}
+		return nil, NewConfError(ErrCannotFindHAProxy, fmt.Sprintf("path to HAProxy binary not valid: %s, err: %s", opt.Haproxy, err.Error()))
 	}
-	patch, err := strconv.ParseInt(versionInts[2], 10, 64)
+	// an unknown version is not an error, capabilities are then not checked
+	version, _ := parseHAProxyVersion(versionString)
+	return version, nil
+}
+
+//nolint:noctx
+func (c *client) fetchVersion(haproxy string) (string, error) {
+	versionString, err := exec.Command(haproxy, "-v").Output()
 	if err != nil {
-		return 0, 0, 0, errors.New("not a haproxy version string")
+		return "", err
 	}
-	return major, minor, patch, nil
+	return string(versionString), nil
 }
 
-func noNamedDefaultsFrom(version string) bool {
-	major, minor, _, err := getVersionNumbers(version)
-	if err != nil {
+func noNamedDefaultsFrom(version *semver.Version) bool {
+	if version == nil {
 		return true
 	}
 
-	return major < 2 || (major == 2 && minor < 4)
+	return version.Major() < 2 || (version.Major() == 2 && version.Minor() < 4)
 }
@@ -0,0 +1,118 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/capabilities"
+	"github.com/haproxytech/client-native/v6/configuration/options"
+)
+
+func TestNew_WithoutHAProxyBinary(t *testing.T) {
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, "haproxy.cfg")
+	if err := os.WriteFile(cfgFile, []byte("global\n  daemon\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	missing := filepath.Join(dir, "haproxy")
+	base := []options.ConfigurationOption{
+		options.ConfigurationFile(cfgFile),
+		options.TransactionsDir(filepath.Join(dir, "transactions")),
+		options.HAProxyBin(missing),
+	}
+
+	if _, err := New(context.Background(), base...); !errors.Is(err, ErrCannotFindHAProxy) {
+		t.Fatalf("New() error = %v, want ErrCannotFindHAProxy", err)
+	}
+
+	c, err := New(context.Background(), append(base, options.SkipConfigurationFileValidation)...)
+	if err != nil {
+		t.Fatalf("New() with skipped validation error = %v", err)
+	}
+	if c.HAProxyVersion() != nil {
+		t.Errorf("HAProxyVersion() = %v, want unknown version", c.HAProxyVersion())
+	}
+
+	c, err = New(context.Background(), append(base, options.HAProxyVersion("3.1"))...)
+	if err != nil {
+		t.Fatalf("New() with version error = %v", err)
+	}
+	if got := c.HAProxyVersion().String(); got != "3.1.0" {
+		t.Errorf("HAProxyVersion() = %s, want 3.1.0", got)
+	}
+	if err = c.CheckCapability(capabilities.Keyword, capabilities.KeywordAcme); !errors.Is(err, ErrUnsupported) || !errors.Is(err, capabilities.ErrUnsupported) {
+		t.Errorf("CheckCapability(acme) error = %v, want ErrUnsupported", err)
+	}
+	config := "global\n  daemon\n"
+	if err = c.PostRawConfiguration(&config, 1, false, true); !errors.Is(err, ErrCannotFindHAProxy) {
+		t.Errorf("PostRawConfiguration() validation error = %v, want ErrCannotFindHAProxy", err)
+	}
+
+	c, err = New(context.Background(), append(base, options.HAProxyVersionFrom(func() (string, error) {
+		return "3.2.0-dev6-2f6f36-13", nil
+	}))...)
+	if err != nil {
+		t.Fatalf("New() with version provider error = %v", err)
+	}
+	if err = c.CheckCapability(capabilities.Keyword, capabilities.KeywordAcme); err != nil {
+		t.Errorf("CheckCapability(acme) error = %v", err)
+	}
+
+	if _, err = New(context.Background(), append(base, options.HAProxyVersion("latest"))...); !errors.Is(err, ErrCannotReadVersion) {
+		t.Errorf("New() with invalid version error = %v, want ErrCannotReadVersion", err)
+	}
+}
+
+func TestCheckCapability_Option(t *testing.T) {
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, "haproxy.cfg")
+	if err := os.WriteFile(cfgFile, []byte("# _version=1\nglobal\n  daemon\n\ndefaults unnamed_defaults_1\n  mode http\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c, err := New(context.Background(),
+		options.ConfigurationFile(cfgFile),
+		options.TransactionsDir(filepath.Join(dir, "transactions")),
+		options.HAProxyBin(filepath.Join(dir, "haproxy")),
+		options.SkipConfigurationFileValidation,
+		options.HAProxyVersion("2.5"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, defaults, err := c.GetDefaultsSection("unnamed_defaults_1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaults.HTTPRestrictReqHdrNames = "delete"
+	err = c.EditDefaultsSection("unnamed_defaults_1", defaults, "", 1)
+	if !errors.Is(err, ErrUnsupported) || !errors.Is(err, capabilities.ErrUnsupported) {
+		t.Errorf("EditDefaultsSection() error = %v, want ErrUnsupported", err)
+	}
+	err = c.EditStructuredDefaultsSection("unnamed_defaults_1", defaults, "", 1)
+	if !errors.Is(err, ErrUnsupported) {
+		t.Errorf("EditStructuredDefaultsSection() error = %v, want ErrUnsupported", err)
+	}
+	if data, _ := os.ReadFile(cfgFile); bytes.Contains(data, []byte("http-restrict-req-hdr-names")) {
+		t.Errorf("configuration file has the unsupported option:\n%s", data)
+	}
+}
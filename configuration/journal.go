@@ -0,0 +1,254 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sort"
+	"time"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+)
+
+type Journal interface {
+	// SetTransactionReason sets why a transaction is made, recorded in the journal when it is committed.
+	SetTransactionReason(transactionID, reason string) error
+	// GetJournal returns the journal entries matching the filter, oldest first.
+	GetJournal(filter JournalFilter) ([]JournalEntry, error)
+	// ExportJournal writes the journal entries matching the filter as JSON lines.
+	ExportJournal(w io.Writer, filter JournalFilter) error
+	// JournalErrors returns the number of commits which could not be recorded in the journal, and the last failure.
+	JournalErrors() (int64, error)
+}
+
+// JournalEntry records a commit of the configuration
+type JournalEntry struct {
+	// Version is the configuration version created by the commit
+	Version       int64     `json:"version"`
+	Timestamp     time.Time `json:"timestamp"`
+	TransactionID string    `json:"transaction_id"`
+	// Author is the owner of the transaction
+	Author  string          `json:"author,omitempty"`
+	Reason  string          `json:"reason,omitempty"`
+	Changes []JournalChange `json:"changes,omitempty"`
+	// BackupFile is the backup of the configuration before the commit, empty without backups.
+	// Older backups are removed, the file might not exist anymore.
+	BackupFile string `json:"backup_file,omitempty"`
+}
+
+// JournalChange summarizes a change of a section
+type JournalChange struct {
+	Type    ChangeType     `json:"type"`
+	Section parser.Section `json:"section"`
+	Name    string         `json:"name,omitempty"`
+	// Fields are the changed fields of modified sections
+	Fields []string `json:"fields,omitempty"`
+}
+
+// JournalFilter selects journal entries, zero values match everything
+type JournalFilter struct {
+	Since       time.Time
+	Until       time.Time
+	FromVersion int64
+	ToVersion   int64
+	Author      string
+	// Section and Name select the entries changing a section
+	Section parser.Section
+	Name    string
+}
+
+// Match returns true if the entry is selected by the filter
+func (f JournalFilter) Match(e JournalEntry) bool {
+	switch {
+	case !f.Since.IsZero() && e.Timestamp.Before(f.Since):
+		return false
+	case !f.Until.IsZero() && e.Timestamp.After(f.Until):
+		return false
+	case f.FromVersion != 0 && e.Version < f.FromVersion:
+		return false
+	case f.ToVersion != 0 && e.Version > f.ToVersion:
+		return false
+	case f.Author != "" && e.Author != f.Author:
+		return false
+	}
+	if f.Section == "" && f.Name == "" {
+		return true
+	}
+	for _, c := range e.Changes {
+		if (f.Section == "" || c.Section == f.Section) && (f.Name == "" || c.Name == f.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetTransactionReason sets why a transaction is made, recorded in the journal when it is committed
+func (t *Transaction) SetTransactionReason(transactionID, reason string) error {
+	if !t.TransactionClient.HasParser(transactionID) {
+		return NewConfError(ErrTransactionDoesNotExist, fmt.Sprintf("transaction %v does not exist", transactionID))
+	}
+	t.metadataMu.Lock()
+	defer t.metadataMu.Unlock()
+	m := t.activeTransactionMetadata(transactionID)
+	if m == nil {
+		// started before a restart, without metadata
+		m = &transactionMetadata{}
+		t.metadata[transactionID] = m
+	}
+	m.reason = reason
+	t.saveTransactionMetadata(transactionID, m)
+	return nil
+}
+
+// GetJournal returns the journal entries matching the filter, oldest first
+func (c *client) GetJournal(filter JournalFilter) ([]JournalEntry, error) {
+	entries := []JournalEntry{}
+	err := c.readJournal(func(e JournalEntry) error {
+		if filter.Match(e) {
+			entries = append(entries, e)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ExportJournal writes the journal entries matching the filter as JSON lines
+func (c *client) ExportJournal(w io.Writer, filter JournalFilter) error {
+	encoder := json.NewEncoder(w)
+	return c.readJournal(func(e JournalEntry) error {
+		if !filter.Match(e) {
+			return nil
+		}
+		if err := encoder.Encode(e); err != nil {
+			return NewConfError(ErrGeneralError, err.Error())
+		}
+		return nil
+	})
+}
+
+func (c *client) readJournal(fn func(JournalEntry) error) error {
+	if c.JournalFile == "" {
+		return NewConfError(ErrGeneralError, "journal is not enabled")
+	}
+	f, err := os.Open(c.JournalFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return NewConfError(ErrCannotReadConfFile, err.Error())
+	}
+	defer f.Close()
+	decoder := json.NewDecoder(f)
+	for {
+		var e JournalEntry
+		if err = decoder.Decode(&e); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return NewConfError(ErrCannotReadConfFile, fmt.Sprintf("invalid journal %s: %s", c.JournalFile, err.Error()))
+		}
+		if err = fn(e); err != nil {
+			return err
+		}
+	}
+}
+
+// prepareJournalEntry returns the entry of a transaction about to be committed,
+// nil when no journal is kept. It must be called before the configuration is replaced.
+func (c *client) prepareJournalEntry(transactionID string) *JournalEntry {
+	if c.JournalFile == "" {
+		return nil
+	}
+	entry := &JournalEntry{TransactionID: transactionID}
+	if m, ok := c.transactionMetadataOf(transactionID); ok {
+		entry.Author = m.owner
+		entry.Reason = m.reason
+	}
+
+	// the journal is kept even when the changes can't be computed
+	current, err := c.configurationAt(ConfigurationRef{})
+	if err != nil {
+		return entry
+	}
+	transaction, err := c.configurationAt(ConfigurationRef{TransactionID: transactionID})
+	if err != nil {
+		return entry
+	}
+	changes, err := diffSnapshots(current, transaction)
+	if err != nil {
+		return entry
+	}
+	for _, change := range changes {
+		jc := JournalChange{Type: change.Type, Section: change.Section, Name: change.Name}
+		for field := range change.Fields {
+			jc.Fields = append(jc.Fields, field)
+		}
+		sort.Strings(jc.Fields)
+		entry.Changes = append(entry.Changes, jc)
+	}
+	return entry
+}
+
+// JournalErrors returns the number of commits which could not be recorded in the journal, and the last failure
+func (c *client) JournalErrors() (int64, error) {
+	c.journalMu.Lock()
+	defer c.journalMu.Unlock()
+	return c.journalFailures, c.journalErr
+}
+
+// appendJournalEntry appends the entry of a committed transaction to the journal.
+// The commit is done, so failures are logged and counted instead of returned.
+func (c *client) appendJournalEntry(entry *JournalEntry) {
+	err := c.writeJournalEntry(entry)
+	if err == nil {
+		return
+	}
+	slog.Warn("cannot record commit in journal", "journal", c.JournalFile, "transaction", entry.TransactionID, "version", entry.Version, "error", err)
+	c.journalMu.Lock()
+	defer c.journalMu.Unlock()
+	c.journalFailures++
+	c.journalErr = fmt.Errorf("transaction %s: %w", entry.TransactionID, err)
+}
+
+func (c *client) writeJournalEntry(entry *JournalEntry) error {
+	entry.Timestamp = time.Now()
+	entry.Version, _ = c.GetVersion("")
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(c.JournalFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
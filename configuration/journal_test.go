@@ -0,0 +1,164 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+	"github.com/haproxytech/client-native/v6/configuration/options"
+)
+
+func TestJournal(t *testing.T) {
+	journalFile := filepath.Join(t.TempDir(), "journal.jsonl")
+	c, cfgFile := newDiffTestClient(t, options.JournalFile(journalFile), options.Backups(3))
+
+	entries, err := c.GetJournal(JournalFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("journal before any commit = %+v, want empty", entries)
+	}
+
+	start := time.Now()
+	tx, err := c.StartTransactionWithOwner(2, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.SetTransactionReason(tx.ID, "remove unused backend"); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.DeleteBackend("be_old", tx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.CommitTransaction(tx.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err = c.StartTransactionWithOwner(3, "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	editBackendMode(t, c, "be_app", "tcp", tx.ID)
+	if _, err = c.CommitTransaction(tx.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err = c.GetJournal(JournalFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d journal entries, want 2: %+v", len(entries), entries)
+	}
+	first := entries[0]
+	if first.Version != 3 || first.Author != "alice" || first.Reason != "remove unused backend" {
+		t.Errorf("first entry = %+v, want version 3 by alice with reason", first)
+	}
+	if first.Timestamp.Before(start) {
+		t.Errorf("first entry timestamp %v before %v", first.Timestamp, start)
+	}
+	if first.BackupFile != cfgFile+".2" {
+		t.Errorf("first entry backup = %q, want %q", first.BackupFile, cfgFile+".2")
+	}
+	if len(first.Changes) != 1 || first.Changes[0].Type != ChangeRemoved || first.Changes[0].Name != "be_old" {
+		t.Errorf("first entry changes = %+v, want be_old removed", first.Changes)
+	}
+	second := entries[1]
+	if second.Version != 4 || second.Author != "bob" || second.Reason != "" {
+		t.Errorf("second entry = %+v, want version 4 by bob", second)
+	}
+	if len(second.Changes) != 1 || second.Changes[0].Type != ChangeModified || !slices.Contains(second.Changes[0].Fields, "BackendBase.Mode") {
+		t.Errorf("second entry changes = %+v, want be_app mode modified", second.Changes)
+	}
+
+	for name, tc := range map[string]struct {
+		filter JournalFilter
+		want   []int64
+	}{
+		"author":  {JournalFilter{Author: "bob"}, []int64{4}},
+		"version": {JournalFilter{FromVersion: 3, ToVersion: 3}, []int64{3}},
+		"object":  {JournalFilter{Section: parser.Backends, Name: "be_old"}, []int64{3}},
+		"section": {JournalFilter{Section: parser.Backends}, []int64{3, 4}},
+		"since":   {JournalFilter{Since: time.Now().Add(time.Hour)}, nil},
+	} {
+		entries, err = c.GetJournal(tc.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		versions := []int64{}
+		for _, e := range entries {
+			versions = append(versions, e.Version)
+		}
+		if len(versions) != len(tc.want) || (len(versions) > 0 && versions[0] != tc.want[0]) {
+			t.Errorf("%s: journal versions = %v, want %v", name, versions, tc.want)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err = c.ExportJournal(&buf, JournalFilter{Author: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("exported %d lines, want 1:\n%s", len(lines), buf.String())
+	}
+	var exported JournalEntry
+	if err = json.Unmarshal([]byte(lines[0]), &exported); err != nil {
+		t.Fatal(err)
+	}
+	if exported.Version != 3 || exported.TransactionID != first.TransactionID {
+		t.Errorf("exported entry = %+v, want %+v", exported, first)
+	}
+
+	if err = c.SetTransactionReason("missing", "reason"); err == nil {
+		t.Error("SetTransactionReason() of a missing transaction succeeded")
+	}
+}
+
+func TestJournal_Disabled(t *testing.T) {
+	c, _ := newDiffTestClient(t)
+	if _, err := c.GetJournal(JournalFilter{}); err == nil {
+		t.Error("GetJournal() without a journal file succeeded")
+	}
+}
+
+func TestJournal_AppendFailure(t *testing.T) {
+	// a directory can't be appended to
+	c, _ := newDiffTestClient(t, options.JournalFile(t.TempDir()))
+
+	tx, err := c.StartTransaction(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.DeleteBackend("be_old", tx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.CommitTransaction(tx.ID); err != nil {
+		t.Fatalf("CommitTransaction() error = %v, want the commit done without journal", err)
+	}
+	failures, err := c.JournalErrors()
+	if failures != 1 || err == nil || !strings.Contains(err.Error(), tx.ID) {
+		t.Errorf("JournalErrors() = %d, %v, want 1 failure of transaction %s", failures, err, tx.ID)
+	}
+}
@@ -21,7 +21,6 @@ import (
 
 	"github.com/haproxytech/client-native/v6/config-parser/common"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parsererrors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -173,7 +172,7 @@ func (c *client) DeleteLogForward(name string, transactionID string, version int
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateLogForward(data *models.LogForward, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -203,7 +202,7 @@ func (c *client) CreateLogForward(data *models.LogForward, transactionID string,
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditLogForward(name string, data *models.LogForward, transactionID string, version int64) error { //nolint:revive
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
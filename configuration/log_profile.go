@@ -20,7 +20,7 @@ import (
 	"fmt"
 	"strings"
 
-	strfmt "github.com/go-openapi/strfmt"
+	"github.com/haproxytech/client-native/v6/capabilities"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -94,8 +94,12 @@ func (c *client) DeleteLogProfile(name, transactionID string, version int64) err
 }
 
 func (c *client) CreateLogProfile(data *models.LogProfile, transactionID string, version int64) error {
+	if err := c.CheckCapability(capabilities.Keyword, capabilities.KeywordLogProfile); err != nil {
+		return err
+	}
+
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -124,7 +128,7 @@ func (c *client) CreateLogProfile(data *models.LogProfile, transactionID string,
 
 func (c *client) EditLogProfile(name string, data *models.LogProfile, transactionID string, version int64) error { //nolint:revive
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -19,7 +19,6 @@ import (
 	"errors"
 	"strconv"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -106,7 +105,7 @@ func (c *client) DeleteLogTarget(id int64, parentType string, parentName string,
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateLogTarget(id int64, parentType string, parentName string, data *models.LogTarget, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -139,7 +138,7 @@ func (c *client) CreateLogTarget(id int64, parentType string, parentName string,
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditLogTarget(id int64, parentType string, parentName string, data *models.LogTarget, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -176,7 +175,7 @@ func (c *client) EditLogTarget(id int64, parentType string, parentName string, d
 // Returns error on fail, nil on success.
 func (c *client) ReplaceLogTargets(parentType string, parentName string, data models.LogTargets, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
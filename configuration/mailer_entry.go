@@ -19,7 +19,6 @@ import (
 	"errors"
 	"fmt"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -102,7 +101,7 @@ func (c *client) DeleteMailerEntry(name string, mailersSection string, transacti
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateMailerEntry(mailersSection string, data *models.MailerEntry, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -129,7 +128,7 @@ func (c *client) CreateMailerEntry(mailersSection string, data *models.MailerEnt
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditMailerEntry(name string, mailersSection string, data *models.MailerEntry, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
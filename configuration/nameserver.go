@@ -21,7 +21,6 @@ import (
 	"strconv"
 	"strings"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -105,7 +104,7 @@ func (c *client) DeleteNameserver(name string, resolverSection string, transacti
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateNameserver(resolverSection string, data *models.Nameserver, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -132,7 +131,7 @@ func (c *client) CreateNameserver(resolverSection string, data *models.Nameserve
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditNameserver(name string, resolverSection string, data *models.Nameserver, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -30,3 +30,20 @@ func ConfigurationFile(configurationFile string) ConfigurationOption {
 		configurationFile: configurationFile,
 	}
 }
+
+type cfgFiles struct {
+	paths []string
+}
+
+func (u cfgFiles) Set(p *ConfigurationOptions) error {
+	p.ConfigurationFiles = append(p.ConfigurationFiles, u.paths...)
+	return nil
+}
+
+// ConfigurationFiles sets files and directories loaded after the configuration file, in order,
+// like additional -f arguments of HAProxy. The .cfg files of a directory are loaded in lexical order.
+func ConfigurationFiles(paths ...string) ConfigurationOption {
+	return cfgFiles{
+		paths: paths,
+	}
+}
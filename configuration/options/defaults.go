@@ -16,6 +16,8 @@ limitations under the License.
 
 package options
 
+import "time"
+
 const (
 	// DefaultUseValidation sane default using validation in client native
 	DefaultUseValidation = true
@@ -35,4 +37,7 @@ const (
 
 	// DefaultTimeSuffix uses the most appropriate Time unit for serialization
 	DefaultTimeSuffix = "nearest"
+
+	// DefaultTransactionSweepInterval sane default for how often expired transactions are looked for
+	DefaultTransactionSweepInterval = time.Minute
 )
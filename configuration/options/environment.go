@@ -0,0 +1,35 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package options
+
+type expandEnvironment struct {
+	env map[string]string
+}
+
+func (u expandEnvironment) Set(p *ConfigurationOptions) error {
+	p.ExpandEnvironment = true
+	p.Environment = u.env
+	return nil
+}
+
+// ExpandEnvironment resolves the environment variables of the configuration with env, the environment
+// of the process when nil, changed by the setenv and presetenv of the global section. Models are
+// validated with their variables expanded, the configuration is still saved unexpanded.
+func ExpandEnvironment(env map[string]string) ConfigurationOption {
+	return expandEnvironment{
+		env: env,
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 HAProxy Technologies
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+type haproxyVersion struct {
+	version string
+}
+
+func (u haproxyVersion) Set(p *ConfigurationOptions) error {
+	p.HAProxyVersion = u.version
+	return nil
+}
+
+// HAProxyVersion sets the version of the targeted HAProxy, such as "3.1" or "2.8.5",
+// so the HAProxy binary is not executed to find it.
+func HAProxyVersion(version string) ConfigurationOption {
+	return haproxyVersion{
+		version: version,
+	}
+}
+
+type haproxyVersionFrom struct {
+	provider func() (string, error)
+}
+
+func (u haproxyVersionFrom) Set(p *ConfigurationOptions) error {
+	p.HAProxyVersionProvider = u.provider
+	return nil
+}
+
+// HAProxyVersionFrom sets a function returning the version of the targeted HAProxy,
+// so it can be read from the runtime API of a running HAProxy instead of the binary:
+//
+//	options.HAProxyVersionFrom(func() (string, error) {
+//		v, err := rt.GetVersion()
+//		if err != nil {
+//			return "", err
+//		}
+//		return v.String(), nil
+//	})
+func HAProxyVersionFrom(provider func() (string, error)) ConfigurationOption {
+	return haproxyVersionFrom{
+		provider: provider,
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package options
+
+type journalFile struct {
+	path string
+}
+
+func (u journalFile) Set(p *ConfigurationOptions) error {
+	p.JournalFile = u.path
+	return nil
+}
+
+// JournalFile sets the file every commit is recorded in, with its author, reason and changes
+func JournalFile(path string) ConfigurationOption {
+	return journalFile{
+		path: path,
+	}
+}
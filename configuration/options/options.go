@@ -16,11 +16,20 @@ limitations under the License.
 
 package options
 
+import "time"
+
 type ConfigurationOptions struct {
 	ConfigurationFile string
-	Haproxy           string
-	TransactionDir    string
-	BackupsDir        string
+	// ConfigurationFiles are the files and directories loaded after the ConfigurationFile, in order
+	ConfigurationFiles []string
+	Haproxy            string
+	TransactionDir     string
+	BackupsDir         string
+	// HAProxyVersion is the version of the targeted HAProxy, when set the
+	// HAProxy binary is not needed unless the configuration is validated with it
+	HAProxyVersion string
+	// HAProxyVersionProvider returns the version of the targeted HAProxy, for example from the runtime API
+	HAProxyVersionProvider func() (string, error)
 
 	// ValidateCmd allows specifying a custom script to validate the transaction file.
 	// The injected environment variable DATAPLANEAPI_TRANSACTION_FILE must be used to get the location of the file.
@@ -35,6 +44,18 @@ type ConfigurationOptions struct {
 	SkipConfigurationFileValidation bool // opposite of previously available ValidateConfigurationFile
 	MasterWorker                    bool
 	UseMd5Hash                      bool
+
+	// TransactionTTL is the time after its last change an in progress transaction expires, 0 to never expire
+	TransactionTTL time.Duration
+	// TransactionSweepInterval is how often expired transactions are looked for in the background
+	TransactionSweepInterval time.Duration
+	// JournalFile is the file commits are recorded in, in JSON lines, no journal is kept when empty
+	JournalFile string
+
+	// ExpandEnvironment enables the expansion of the environment variables of the configuration
+	ExpandEnvironment bool
+	// Environment are the environment variables to expand, the environment of the process is used when nil
+	Environment map[string]string
 }
 
 type ConfigurationOption interface {
@@ -0,0 +1,52 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package options
+
+import "time"
+
+type transactionTTL struct {
+	ttl time.Duration
+}
+
+func (u transactionTTL) Set(p *ConfigurationOptions) error {
+	p.TransactionTTL = u.ttl
+	return nil
+}
+
+// TransactionTTL sets the time after its last change an in progress transaction expires.
+// Expired transactions are moved to the `expired` folder, or removed with SkipFailedTransactions.
+func TransactionTTL(ttl time.Duration) ConfigurationOption {
+	return transactionTTL{
+		ttl: ttl,
+	}
+}
+
+type transactionSweepInterval struct {
+	interval time.Duration
+}
+
+func (u transactionSweepInterval) Set(p *ConfigurationOptions) error {
+	p.TransactionSweepInterval = u.interval
+	return nil
+}
+
+// TransactionSweepInterval sets how often expired transactions are looked for in the background,
+// defaults to DefaultTransactionSweepInterval when a TransactionTTL is set
+func TransactionSweepInterval(interval time.Duration) ConfigurationOption {
+	return transactionSweepInterval{
+		interval: interval,
+	}
+}
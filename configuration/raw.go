@@ -22,12 +22,16 @@ import (
 	"os"
 	"strconv"
 	"strings"
+
+	"github.com/haproxytech/client-native/v6/diagnostics"
 )
 
 type Raw interface {
 	GetRawConfigurationWithClusterData(transactionID string, version int64) (int64, int64, string, string, error)
 	GetRawConfiguration(transactionID string, version int64) (int64, string, error)
 	PostRawConfiguration(config *string, version int64, skipVersionCheck bool, onlyValidate ...bool) error
+	// ValidateRawConfiguration validates a configuration with HAProxy, returning the warnings of the check.
+	ValidateRawConfiguration(config string) (diagnostics.Diagnostics, error)
 }
 
 func (c *client) GetRawConfiguration(transactionID string, version int64) (int64, string, error) {
@@ -43,7 +47,8 @@ func (c *client) GetRawConfigurationWithClusterData(transactionID string, versio
 }
 
 // GetRawConfiguration returns configuration version and a
-// string containing raw config file
+// string containing raw config file, with several configuration files
+// the sections of each other file follow a file marker
 func (c *client) getRawConfiguration(transactionID string, version int64) (int64, int64, string, string, error) {
 	config := c.ConfigurationFile
 	var err error
@@ -66,6 +71,15 @@ func (c *client) getRawConfiguration(transactionID string, version int64) (int64
 		return 0, 0, "", "", metaErr
 	}
 
+	if config == c.ConfigurationFile && len(c.ConfigurationFiles) > 0 {
+		// all the configuration files, as posted back
+		data, err := c.readConfiguration()
+		if err != nil {
+			return 0, 0, "", "", err
+		}
+		return ondiskV, ondiskClusterV, ondiskMD5Hash, data, nil
+	}
+
 	data, err := os.ReadFile(config)
 	if err != nil {
 		return 0, 0, "", "", NewConfError(ErrCannotReadConfFile, err.Error())
@@ -124,26 +138,17 @@ func (c *client) getConfigurationMetaData(config string) (int64, int64, string,
 // PostRawConfiguration pushes given string to the config file if the version
 // matches
 func (c *client) PostRawConfiguration(config *string, version int64, skipVersionCheck bool, onlyValidate ...bool) error {
+	if c.readOnly {
+		return NewConfError(ErrReadOnly, "views of the configuration can't be changed")
+	}
 	if len(onlyValidate) > 0 && onlyValidate[0] {
-		f, err := os.CreateTemp("/tmp", "onlyvalidate")
-		if err != nil {
-			return NewConfError(ErrGeneralError, err.Error())
-		}
-		defer os.Remove(f.Name())
-		_, err = f.WriteString(*config)
-		if err != nil {
-			return NewConfError(ErrGeneralError, err.Error())
-		}
-		err = f.Sync()
-		if err != nil {
-			return NewConfError(ErrGeneralError, err.Error())
-		}
-		return checkHaproxyConfiguration(c.ConfigurationOptions, f.Name())
+		_, err := c.ValidateRawConfiguration(*config)
+		return err
 	}
 	var t string
 	if skipVersionCheck {
 		// Create impicit transaction
-		transaction, err := c.startTransaction(version, skipVersionCheck)
+		transaction, err := c.startTransaction(version, skipVersionCheck, "")
 		if err != nil {
 			return err
 		}
@@ -190,15 +195,38 @@ func (c *client) PostRawConfiguration(config *string, version int64, skipVersion
 	if err := p.LoadData(tFile); err != nil {
 		return NewConfError(ErrCannotReadConfFile, "Cannot read "+tFile)
 	}
+	if len(c.ConfigurationFiles) > 0 {
+		if err := c.checkConfigurationFiles(p); err != nil {
+			return c.ErrAndDeleteTransaction(err, t)
+		}
+	}
 
 	// Do a regular commit of the transaction
-	if _, err := c.commitTransaction(t, skipVersionCheck); err != nil {
+	if _, _, err := c.commitTransaction(t, skipVersionCheck); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// ValidateRawConfiguration validates a configuration with HAProxy, returning the warnings of the check
+func (c *client) ValidateRawConfiguration(config string) (diagnostics.Diagnostics, error) {
+	f, err := os.CreateTemp("/tmp", "onlyvalidate")
+	if err != nil {
+		return nil, NewConfError(ErrGeneralError, err.Error())
+	}
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(config)
+	if err != nil {
+		return nil, NewConfError(ErrGeneralError, err.Error())
+	}
+	err = f.Sync()
+	if err != nil {
+		return nil, NewConfError(ErrGeneralError, err.Error())
+	}
+	return checkHaproxyConfiguration(c.ConfigurationOptions, f.Name())
+}
+
 // dropVersionFromRaw is used when force pushing a raw configuration with version check:
 // if the provided user input has already a version metadata it must be withdrawn.
 func (c *client) dropVersionFromRaw(input string) string {
@@ -0,0 +1,297 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+type Rebase interface {
+	// RebaseTransaction replays the changes of a transaction started on an older version onto the current configuration.
+	RebaseTransaction(transactionID string) (*RebaseResult, error)
+}
+
+// RebaseConflict is an object changed both by a transaction and by the commits
+// made since the transaction was started
+type RebaseConflict struct {
+	Section parser.Section
+	Name    string
+	// Fields are the paths of the attributes of a section modified on both sides with different values,
+	// empty when the section was added or removed on one side
+	Fields []string
+	// Transaction is the change made by the transaction
+	Transaction SectionChange
+	// Configuration is the change committed since the transaction was started
+	Configuration SectionChange
+}
+
+// RebaseResult is the result of a transaction rebase
+type RebaseResult struct {
+	// Transaction is the rebased transaction, nil when there are conflicts
+	Transaction *models.Transaction
+	// Applied are the changes of the transaction replayed onto the current configuration
+	Applied []SectionChange
+	// Conflicts are the objects changed on both sides, nothing is applied when there are any
+	Conflicts []RebaseConflict
+}
+
+// RebaseTransaction replays the changes of an outdated or in progress transaction onto the current
+// configuration, in a new transaction replacing it. The changes are computed against the configuration
+// saved when the transaction was started, or else against the backup of its version.
+// Sections modified on both sides are merged when their changes touch different attributes, servers,
+// binds or rules. When an object was changed both by the transaction and by the commits made since,
+// nothing is applied and the conflicts are returned with an ErrRebaseConflict error.
+func (c *client) RebaseTransaction(transactionID string) (*RebaseResult, error) {
+	data, transactionFile, err := c.transactionData(transactionID)
+	if err != nil {
+		return nil, err
+	}
+	transaction, err := c.snapshot(data)
+	if err != nil {
+		return nil, err
+	}
+	transactionVersion, err := transaction.GetVersion("")
+	if err != nil {
+		return nil, err
+	}
+	version, err := c.GetVersion("")
+	if err != nil {
+		return nil, err
+	}
+	if transactionVersion >= version {
+		return nil, NewConfError(ErrVersionMismatch, fmt.Sprintf("transaction %s version (%d) is not older than the current one (%d)", transactionID, transactionVersion, version))
+	}
+
+	base, err := c.transactionBase(transactionID, transactionVersion)
+	if err != nil {
+		return nil, err
+	}
+	current, err := c.configurationAt(ConfigurationRef{})
+	if err != nil {
+		return nil, err
+	}
+	ours, err := diffSnapshots(base, transaction)
+	if err != nil {
+		return nil, err
+	}
+	theirs, err := diffSnapshots(base, current)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RebaseResult{}
+	committed := make(map[string]SectionChange, len(theirs))
+	for _, change := range theirs {
+		committed[changeKey(change)] = change
+	}
+	for _, change := range ours {
+		other, ok := committed[changeKey(change)]
+		if !ok {
+			result.Applied = append(result.Applied, change)
+			continue
+		}
+		// the same change was already committed
+		if change.Type == other.Type && reflect.DeepEqual(change.To, other.To) {
+			continue
+		}
+		var fields []string
+		if change.Type == ChangeModified && other.Type == ChangeModified {
+			merged, conflicts, err := mergeChanges(change, other)
+			if err != nil {
+				return nil, err
+			}
+			if len(conflicts) == 0 {
+				if merged != nil {
+					result.Applied = append(result.Applied, *merged)
+				}
+				continue
+			}
+			fields = conflicts
+		}
+		result.Conflicts = append(result.Conflicts, RebaseConflict{
+			Section:       change.Section,
+			Name:          change.Name,
+			Fields:        fields,
+			Transaction:   change,
+			Configuration: other,
+		})
+	}
+	if len(result.Conflicts) > 0 {
+		result.Applied = nil
+		return result, NewConfError(ErrRebaseConflict, fmt.Sprintf("%d objects of transaction %s were changed since version %d", len(result.Conflicts), transactionID, transactionVersion))
+	}
+
+	result.Transaction, err = c.StartTransaction(version)
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range result.Applied {
+		if err = c.applyChange(change, result.Transaction.ID); err != nil {
+			_ = c.DeleteTransaction(result.Transaction.ID)
+			return nil, err
+		}
+	}
+
+	// the rebased transaction replaces the old one
+	_ = c.DeleteTransaction(transactionID)
+	if transactionFile != "" {
+		_ = os.Remove(transactionFile)
+		_ = os.Remove(metadataFile(transactionFile))
+		_ = os.Remove(baseFile(transactionFile))
+	}
+	return result, nil
+}
+
+// transactionBase returns the configuration a transaction was started from, as saved with the
+// transaction, or else from the backup of its version
+func (c *client) transactionBase(transactionID string, version int64) (*client, error) {
+	if transactionFile, err := c.GetTransactionFile(transactionID); err == nil {
+		if data, err := os.ReadFile(baseFile(transactionFile)); err == nil {
+			return c.snapshot(string(data))
+		}
+	}
+	return c.configurationAt(ConfigurationRef{Version: version})
+}
+
+// transactionData returns the configuration of a transaction, from its parser when it is
+// in progress, else from its file with the path of the file
+func (c *client) transactionData(transactionID string) (string, string, error) {
+	if transactionID == "" {
+		return "", "", NewConfError(ErrValidationError, "Not a valid transaction")
+	}
+	if c.HasParser(transactionID) {
+		p, err := c.GetParser(transactionID)
+		if err != nil {
+			return "", "", err
+		}
+		return p.String(), "", nil
+	}
+	transactionFile, err := c.GetTransactionFile(transactionID)
+	if err != nil {
+		return "", "", err
+	}
+	data, err := os.ReadFile(transactionFile)
+	if err != nil {
+		return "", "", NewConfError(ErrCannotReadConfFile, err.Error())
+	}
+	return string(data), transactionFile, nil
+}
+
+func changeKey(change SectionChange) string {
+	return string(change.Section) + " " + change.Name
+}
+
+// mergeChanges merges the modifications of a section made by a transaction and by the commits made
+// since, attribute by attribute. It returns the change to apply onto the current section, nil when
+// there is nothing left to change, or the paths of the attributes changed on both sides.
+func mergeChanges(ours, theirs SectionChange) (*SectionChange, []string, error) {
+	var base, transaction, current any
+	for _, v := range []struct {
+		model any
+		tree  *any
+	}{{ours.From, &base}, {ours.To, &transaction}, {theirs.To, &current}} {
+		data, err := json.Marshal(v.model)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = json.Unmarshal(data, v.tree); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var conflicts []string
+	merged := mergeValues("", base, transaction, current, &conflicts)
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return nil, conflicts, nil
+	}
+	if reflect.DeepEqual(merged, current) {
+		return nil, nil, nil
+	}
+	change := SectionChange{Type: ChangeModified, Section: ours.Section, Name: ours.Name, Fields: ours.Fields, From: theirs.To, To: ours.To}
+	if reflect.DeepEqual(merged, transaction) {
+		return &change, nil, nil
+	}
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, nil, err
+	}
+	model := reflect.New(reflect.TypeOf(ours.To).Elem()).Interface()
+	if err = json.Unmarshal(data, model); err != nil {
+		return nil, nil, err
+	}
+	change.To = model
+	return &change, nil, nil
+}
+
+// mergeValues merges two JSON values changed from a common base, objects key by key and lists of
+// the same length item by item. The paths of the values changed on both sides are added to conflicts.
+func mergeValues(path string, base, ours, theirs any, conflicts *[]string) any {
+	switch {
+	case reflect.DeepEqual(ours, theirs), reflect.DeepEqual(theirs, base):
+		return ours
+	case reflect.DeepEqual(ours, base):
+		return theirs
+	}
+
+	baseMap, baseOk := base.(map[string]any)
+	oursMap, oursOk := ours.(map[string]any)
+	theirsMap, theirsOk := theirs.(map[string]any)
+	if baseOk && oursOk && theirsOk {
+		merged := make(map[string]any, len(theirsMap))
+		keys := make(map[string]struct{}, len(oursMap)+len(theirsMap))
+		for _, m := range []map[string]any{baseMap, oursMap, theirsMap} {
+			for k := range m {
+				keys[k] = struct{}{}
+			}
+		}
+		for k := range keys {
+			if v := mergeValues(joinPath(path, k), baseMap[k], oursMap[k], theirsMap[k], conflicts); v != nil {
+				merged[k] = v
+			}
+		}
+		return merged
+	}
+
+	baseList, baseOk := base.([]any)
+	oursList, oursOk := ours.([]any)
+	theirsList, theirsOk := theirs.([]any)
+	if baseOk && oursOk && theirsOk && len(baseList) == len(oursList) && len(oursList) == len(theirsList) {
+		merged := make([]any, len(theirsList))
+		for i := range theirsList {
+			merged[i] = mergeValues(path+"["+strconv.Itoa(i)+"]", baseList[i], oursList[i], theirsList[i], conflicts)
+		}
+		return merged
+	}
+
+	*conflicts = append(*conflicts, path)
+	return theirs
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,277 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	parser "github.com/haproxytech/client-native/v6/config-parser"
+	"github.com/haproxytech/client-native/v6/configuration/options"
+	"github.com/haproxytech/client-native/v6/misc"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+func editBackendMode(t *testing.T, c Configuration, name, mode, transactionID string) {
+	t.Helper()
+	_, b, err := c.GetBackend(name, transactionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Mode = mode
+	if err = c.EditBackend(name, b, transactionID, 0); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRebaseTransaction(t *testing.T) {
+	c, _ := newDiffTestClient(t, options.Backups(5), options.UsePersistentTransactions)
+
+	outdated, err := c.StartTransaction(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.CreateBackend(&models.Backend{BackendBase: models.BackendBase{Name: "be_new", Mode: "http"}}, outdated.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	editBackendMode(t, c, "be_app", "tcp", outdated.ID)
+
+	// the same change and a disjoint one are committed first
+	tx, err := c.StartTransaction(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	editBackendMode(t, c, "be_app", "tcp", tx.ID)
+	editBackendMode(t, c, "be_static", "tcp", tx.ID)
+	if _, err = c.CommitTransaction(tx.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.MarkTransactionOutdated(outdated.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := c.RebaseTransaction(outdated.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Applied) != 1 || result.Applied[0].Type != ChangeAdded || result.Applied[0].Name != "be_new" {
+		t.Errorf("applied changes = %+v, want be_new added", result.Applied)
+	}
+	if result.Transaction.Version != 3 {
+		t.Errorf("rebased transaction version = %d, want 3", result.Transaction.Version)
+	}
+	if _, err = c.GetTransaction(outdated.ID); !errors.Is(err, ErrTransactionDoesNotExist) {
+		t.Errorf("GetTransaction() of the rebased transaction error = %v, want ErrTransactionDoesNotExist", err)
+	}
+	if _, err = c.CommitTransaction(result.Transaction.ID); err != nil {
+		t.Fatal(err)
+	}
+	for name, mode := range map[string]string{"be_new": "http", "be_app": "tcp", "be_static": "tcp"} {
+		_, b, err := c.GetBackend(name, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b.Mode != mode {
+			t.Errorf("backend %s mode = %s, want %s", name, b.Mode, mode)
+		}
+	}
+}
+
+func TestRebaseTransaction_WithoutBackups(t *testing.T) {
+	c, _ := newDiffTestClient(t, options.UsePersistentTransactions)
+
+	outdated, err := c.StartTransaction(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	editBackendMode(t, c, "be_app", "tcp", outdated.ID)
+	_, b, err := c.GetBackend("be_static", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Mode = "tcp"
+	if err = c.EditBackend("be_static", b, "", 2); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.MarkTransactionOutdated(outdated.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// the configuration the transaction was started from moved with it
+	result, err := c.RebaseTransaction(outdated.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Applied) != 1 || result.Applied[0].Name != "be_app" {
+		t.Errorf("applied changes = %+v, want be_app modified", result.Applied)
+	}
+	if _, err = c.CommitTransaction(result.Transaction.ID); err != nil {
+		t.Fatal(err)
+	}
+	for name, mode := range map[string]string{"be_app": "tcp", "be_static": "tcp"} {
+		_, b, err := c.GetBackend(name, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b.Mode != mode {
+			t.Errorf("backend %s mode = %s, want %s", name, b.Mode, mode)
+		}
+	}
+}
+
+func TestRebaseTransaction_Conflict(t *testing.T) {
+	c, _ := newDiffTestClient(t, options.Backups(5))
+
+	outdated, err := c.StartTransaction(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	editBackendMode(t, c, "be_app", "tcp", outdated.ID)
+
+	tx, err := c.StartTransaction(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.DeleteBackend("be_app", tx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.CommitTransaction(tx.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := c.RebaseTransaction(outdated.ID)
+	if !errors.Is(err, ErrRebaseConflict) {
+		t.Fatalf("RebaseTransaction() error = %v, want ErrRebaseConflict", err)
+	}
+	if len(result.Conflicts) != 1 {
+		t.Fatalf("conflicts = %+v, want 1", result.Conflicts)
+	}
+	conflict := result.Conflicts[0]
+	if conflict.Section != parser.Backends || conflict.Name != "be_app" ||
+		conflict.Transaction.Type != ChangeModified || conflict.Configuration.Type != ChangeRemoved {
+		t.Errorf("conflict = %+v, want be_app modified and removed", conflict)
+	}
+	if _, err = c.GetTransaction(outdated.ID); err != nil {
+		t.Errorf("GetTransaction() of the conflicting transaction error = %v", err)
+	}
+}
+
+const rebaseMergeTestConfig = `# _version=2
+global
+  daemon
+
+backend be_app
+  mode http
+  # pinned to the first rack
+  balance roundrobin
+  server s1 127.0.0.1:81
+  server s2 127.0.0.1:82
+`
+
+func editServerAddress(t *testing.T, c Configuration, name, address, transactionID string) {
+	t.Helper()
+	_, s, err := c.GetServer(name, BackendParentName, "be_app", transactionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Address = address
+	if err = c.EditServer(name, BackendParentName, "be_app", s, transactionID, 0); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRebaseTransaction_Merge(t *testing.T) {
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, "haproxy.cfg")
+	if err := os.WriteFile(cfgFile, []byte(rebaseMergeTestConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c, err := New(context.Background(),
+		options.ConfigurationFile(cfgFile),
+		options.TransactionsDir(filepath.Join(dir, "transactions")),
+		options.HAProxyVersion("3.1"),
+		options.SkipConfigurationFileValidation,
+		options.Backups(5),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// disjoint edits of the same backend
+	outdated, err := c.StartTransaction(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	editServerAddress(t, c, "s1", "10.0.0.1", outdated.ID)
+	conflicting, err := c.StartTransaction(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	editServerAddress(t, c, "s2", "10.0.0.3", conflicting.ID)
+
+	tx, err := c.StartTransaction(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	editServerAddress(t, c, "s2", "10.0.0.2", tx.ID)
+	if err = c.CreateServer(BackendParentName, "be_app", &models.Server{Name: "s3", Address: "127.0.0.1", Port: misc.Int64P(83)}, tx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.CommitTransaction(tx.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := c.RebaseTransaction(outdated.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Applied) != 1 || result.Applied[0].Name != "be_app" {
+		t.Errorf("applied changes = %+v, want be_app merged", result.Applied)
+	}
+	if _, err = c.CommitTransaction(result.Transaction.ID); err != nil {
+		t.Fatal(err)
+	}
+	for name, address := range map[string]string{"s1": "10.0.0.1", "s2": "10.0.0.2", "s3": "127.0.0.1"} {
+		_, s, err := c.GetServer(name, BackendParentName, "be_app", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Address != address {
+			t.Errorf("server %s address = %s, want %s", name, s.Address, address)
+		}
+	}
+	data, err := os.ReadFile(cfgFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "# pinned to the first rack") {
+		t.Errorf("comment of be_app lost:\n%s", data)
+	}
+
+	// the same server edited on both sides
+	result, err = c.RebaseTransaction(conflicting.ID)
+	if !errors.Is(err, ErrRebaseConflict) {
+		t.Fatalf("RebaseTransaction() error = %v, want ErrRebaseConflict", err)
+	}
+	if len(result.Conflicts) != 1 || !reflect.DeepEqual(result.Conflicts[0].Fields, []string{"servers.s2.address"}) {
+		t.Errorf("conflicts = %+v, want servers.s2.address", result.Conflicts)
+	}
+}
@@ -20,7 +20,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/common"
 	cp_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
@@ -117,7 +116,7 @@ func (c *client) DeleteResolver(name string, transactionID string, version int64
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditResolver(name string, data *models.Resolver, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -144,7 +143,7 @@ func (c *client) EditResolver(name string, data *models.Resolver, transactionID
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateResolver(data *models.Resolver, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -19,7 +19,6 @@ import (
 	"errors"
 	"fmt"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parsererrors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -195,7 +194,7 @@ func (c *client) DeleteRing(name string, transactionID string, version int64) er
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateRing(data *models.Ring, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -225,7 +224,7 @@ func (c *client) CreateRing(data *models.Ring, transactionID string, version int
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditRing(name string, data *models.Ring, transactionID string, version int64) error { //nolint:revive
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
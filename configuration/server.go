@@ -21,7 +21,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/params"
@@ -114,7 +113,7 @@ func (c *client) DeleteServer(name string, parentType string, parentName string,
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateServer(parentType string, parentName string, data *models.Server, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -141,7 +140,7 @@ func (c *client) CreateServer(parentType string, parentName string, data *models
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditServer(name string, parentType string, parentName string, data *models.Server, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -166,7 +165,7 @@ func (c *client) EditServer(name string, parentType string, parentName string, d
 
 func (c *client) CreateOrEditServer(parentType string, parentName string, data *models.Server, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
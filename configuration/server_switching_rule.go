@@ -20,7 +20,6 @@ import (
 	"errors"
 	"strconv"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -92,7 +91,7 @@ func (c *client) DeleteServerSwitchingRule(id int64, backend string, transaction
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateServerSwitchingRule(id int64, backend string, data *models.ServerSwitchingRule, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -113,7 +112,7 @@ func (c *client) CreateServerSwitchingRule(id int64, backend string, data *model
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditServerSwitchingRule(id int64, backend string, data *models.ServerSwitchingRule, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -139,7 +138,7 @@ func (c *client) EditServerSwitchingRule(id int64, backend string, data *models.
 // Returns error on fail, nil on success.
 func (c *client) ReplaceServerSwitchingRules(backend string, data models.ServerSwitchingRules, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
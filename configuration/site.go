@@ -20,7 +20,6 @@ import (
 	"reflect"
 	"strconv"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 
 	"github.com/haproxytech/client-native/v6/misc"
@@ -88,7 +87,7 @@ func (c *client) CreateSite(data *models.Site, transactionID string, version int
 	var err error
 
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -164,7 +163,7 @@ func (c *client) EditSite(name string, data *models.Site, transactionID string,
 	var err error
 
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
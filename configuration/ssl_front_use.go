@@ -17,7 +17,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/params"
@@ -93,7 +92,7 @@ func (c *client) DeleteSSLFrontUse(number int64, parentType string, parentName s
 
 func (c *client) CreateSSLFrontUse(parentType string, parentName string, data *models.SSLFrontUse, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -113,7 +112,7 @@ func (c *client) CreateSSLFrontUse(parentType string, parentName string, data *m
 
 func (c *client) EditSSLFrontUse(number int64, parentType string, parentName string, data *models.SSLFrontUse, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
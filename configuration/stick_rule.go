@@ -19,7 +19,6 @@ import (
 	"errors"
 	"strconv"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -100,7 +99,7 @@ func (c *client) DeleteStickRule(id int64, backend string, transactionID string,
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateStickRule(id int64, backend string, data *models.StickRule, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -121,7 +120,7 @@ func (c *client) CreateStickRule(id int64, backend string, data *models.StickRul
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditStickRule(id int64, backend string, data *models.StickRule, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -147,7 +146,7 @@ func (c *client) EditStickRule(id int64, backend string, data *models.StickRule,
 // Returns error on fail, nil on success.
 func (c *client) ReplaceStickRules(backend string, data models.StickRules, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
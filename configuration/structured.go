@@ -16,6 +16,7 @@
 package configuration
 
 import (
+	"github.com/haproxytech/client-native/v6/capabilities"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/configuration/options"
 )
@@ -43,4 +44,6 @@ type StructuredToParserArgs struct {
 	Parser      *parser.Parser
 	Options     *options.ConfigurationOptions
 	HandleError func(id, parentType, parentName, transactionID string, implicit bool, err error) error
+	// CheckCapability, when set, rejects the options the HAProxy version doesn't support
+	CheckCapability func(kind capabilities.Kind, name string) error
 }
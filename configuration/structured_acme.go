@@ -18,7 +18,6 @@ package configuration
 import (
 	"fmt"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/models"
 )
@@ -71,7 +70,7 @@ func (c *client) GetStructuredAcmeProviders(transactionID string) (int64, models
 
 func (c *client) EditStructuredAcmeProvider(name string, data *models.AcmeProvider, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -104,7 +103,7 @@ func (c *client) EditStructuredAcmeProvider(name string, data *models.AcmeProvid
 
 func (c *client) CreateStructuredAcmeProvider(data *models.AcmeProvider, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -20,7 +20,6 @@ import (
 	"sort"
 	"strconv"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
 	"github.com/haproxytech/client-native/v6/models"
@@ -78,7 +77,7 @@ func (c *client) GetStructuredBackends(transactionID string) (int64, models.Back
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditStructuredBackend(name string, data *models.Backend, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -99,10 +98,11 @@ func (c *client) EditStructuredBackend(name string, data *models.Backend, transa
 	}
 
 	if err = serializeBackendSection(StructuredToParserArgs{
-		TID:         transactionID,
-		Parser:      &p,
-		Options:     &c.ConfigurationOptions,
-		HandleError: c.HandleError,
+		TID:             transactionID,
+		Parser:          &p,
+		Options:         &c.ConfigurationOptions,
+		HandleError:     c.HandleError,
+		CheckCapability: c.CheckCapability,
 	}, data); err != nil {
 		return err
 	}
@@ -113,7 +113,7 @@ func (c *client) EditStructuredBackend(name string, data *models.Backend, transa
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateStructuredBackend(data *models.Backend, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -130,10 +130,11 @@ func (c *client) CreateStructuredBackend(data *models.Backend, transactionID str
 	}
 
 	if err = serializeBackendSection(StructuredToParserArgs{
-		TID:         transactionID,
-		Parser:      &p,
-		Options:     &c.ConfigurationOptions,
-		HandleError: c.HandleError,
+		TID:             transactionID,
+		Parser:          &p,
+		Options:         &c.ConfigurationOptions,
+		HandleError:     c.HandleError,
+		CheckCapability: c.CheckCapability,
 	}, data); err != nil {
 		return err
 	}
@@ -293,7 +294,15 @@ func serializeBackendSection(a StructuredToParserArgs, b *models.Backend) error
 	if err != nil {
 		return a.HandleError(b.Name, "", "", a.TID, a.TID == "", err)
 	}
-	if err = CreateEditSection(&b.BackendBase, parser.Backends, b.Name, p, a.Options); err != nil {
+	so := SectionObject{
+		Object:          &b.BackendBase,
+		Section:         parser.Backends,
+		Name:            b.Name,
+		Parser:          p,
+		Options:         a.Options,
+		CheckCapability: a.CheckCapability,
+	}
+	if err = so.CreateEditSection(); err != nil {
 		return a.HandleError(b.Name, "", "", a.TID, a.TID == "", err)
 	}
 
@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"sort"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	convert "github.com/haproxytech/client-native/v6/configuration/convert/v2v3"
 	"github.com/haproxytech/client-native/v6/models"
@@ -73,7 +72,7 @@ func (c *client) GetStructuredCrtStores(transactionID string) (int64, models.Crt
 
 func (c *client) EditStructuredCrtStore(name string, data *models.CrtStore, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -106,7 +105,7 @@ func (c *client) EditStructuredCrtStore(name string, data *models.CrtStore, tran
 
 func (c *client) CreateStructuredCrtStore(data *models.CrtStore, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
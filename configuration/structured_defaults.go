@@ -20,7 +20,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parserErrors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -102,7 +101,7 @@ func (c *client) GetStructuredDefaultsSections(transactionID string) (int64, mod
 // mandatory. Returns error on fail, nil on success.
 func (c *client) PushStructuredDefaultsConfiguration(data *models.Defaults, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -124,10 +123,11 @@ func (c *client) PushStructuredDefaultsConfiguration(data *models.Defaults, tran
 
 	data.Name = parser.DefaultSectionName
 	if err = serializeDefaultsSection(StructuredToParserArgs{
-		TID:         transactionID,
-		Parser:      &p,
-		Options:     &c.ConfigurationOptions,
-		HandleError: c.HandleError,
+		TID:             transactionID,
+		Parser:          &p,
+		Options:         &c.ConfigurationOptions,
+		HandleError:     c.HandleError,
+		CheckCapability: c.CheckCapability,
 	}, data); err != nil {
 		return err
 	}
@@ -138,7 +138,7 @@ func (c *client) PushStructuredDefaultsConfiguration(data *models.Defaults, tran
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditStructuredDefaultsSection(name string, data *models.Defaults, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -159,10 +159,11 @@ func (c *client) EditStructuredDefaultsSection(name string, data *models.Default
 	}
 
 	if err = serializeDefaultsSection(StructuredToParserArgs{
-		TID:         transactionID,
-		Parser:      &p,
-		Options:     &c.ConfigurationOptions,
-		HandleError: c.HandleError,
+		TID:             transactionID,
+		Parser:          &p,
+		Options:         &c.ConfigurationOptions,
+		HandleError:     c.HandleError,
+		CheckCapability: c.CheckCapability,
 	}, data); err != nil {
 		return err
 	}
@@ -173,7 +174,7 @@ func (c *client) EditStructuredDefaultsSection(name string, data *models.Default
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateStructuredDefaultsSection(data *models.Defaults, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -190,10 +191,11 @@ func (c *client) CreateStructuredDefaultsSection(data *models.Defaults, transact
 	}
 
 	if err = serializeDefaultsSection(StructuredToParserArgs{
-		TID:         transactionID,
-		Parser:      &p,
-		Options:     &c.ConfigurationOptions,
-		HandleError: c.HandleError,
+		TID:             transactionID,
+		Parser:          &p,
+		Options:         &c.ConfigurationOptions,
+		HandleError:     c.HandleError,
+		CheckCapability: c.CheckCapability,
 	}, data); err != nil {
 		return err
 	}
@@ -291,7 +293,15 @@ func serializeDefaultsSection(a StructuredToParserArgs, d *models.Defaults) erro
 			return err
 		}
 	}
-	if err = CreateEditSection(&d.DefaultsBase, parser.Defaults, d.Name, p, a.Options); err != nil {
+	so := SectionObject{
+		Object:          &d.DefaultsBase,
+		Section:         parser.Defaults,
+		Name:            d.Name,
+		Parser:          p,
+		Options:         a.Options,
+		CheckCapability: a.CheckCapability,
+	}
+	if err = so.CreateEditSection(); err != nil {
 		return a.HandleError(d.Name, "", "", a.TID, a.TID == "", err)
 	}
 	for i, log := range d.LogTargetList {
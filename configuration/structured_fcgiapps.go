@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/models"
 )
@@ -76,7 +75,7 @@ func (c *client) GetStructuredFCGIApplications(transactionID string) (int64, mod
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditStructuredFCGIApplication(name string, data *models.FCGIApp, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -111,7 +110,7 @@ func (c *client) EditStructuredFCGIApplication(name string, data *models.FCGIApp
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateStructuredFCGIApplication(data *models.FCGIApp, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
 	"github.com/haproxytech/client-native/v6/configuration/options"
@@ -78,7 +77,7 @@ func (c *client) GetStructuredFrontends(transactionID string) (int64, models.Fro
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditStructuredFrontend(name string, data *models.Frontend, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -99,10 +98,11 @@ func (c *client) EditStructuredFrontend(name string, data *models.Frontend, tran
 	}
 
 	if err = serializeFrontendSection(StructuredToParserArgs{
-		TID:         transactionID,
-		Parser:      &p,
-		Options:     &c.ConfigurationOptions,
-		HandleError: c.HandleError,
+		TID:             transactionID,
+		Parser:          &p,
+		Options:         &c.ConfigurationOptions,
+		HandleError:     c.HandleError,
+		CheckCapability: c.CheckCapability,
 	}, data, &c.ConfigurationOptions); err != nil {
 		return err
 	}
@@ -113,7 +113,7 @@ func (c *client) EditStructuredFrontend(name string, data *models.Frontend, tran
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateStructuredFrontend(data *models.Frontend, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -130,10 +130,11 @@ func (c *client) CreateStructuredFrontend(data *models.Frontend, transactionID s
 	}
 
 	if err = serializeFrontendSection(StructuredToParserArgs{
-		TID:         transactionID,
-		Parser:      &p,
-		Options:     &c.ConfigurationOptions,
-		HandleError: c.HandleError,
+		TID:             transactionID,
+		Parser:          &p,
+		Options:         &c.ConfigurationOptions,
+		HandleError:     c.HandleError,
+		CheckCapability: c.CheckCapability,
 	}, data, &c.ConfigurationOptions); err != nil {
 		return err
 	}
@@ -282,7 +283,15 @@ func serializeFrontendSection(a StructuredToParserArgs, f *models.Frontend, opt
 	if err != nil {
 		return a.HandleError(f.Name, "", "", a.TID, a.TID == "", err)
 	}
-	if err = CreateEditSection(&f.FrontendBase, parser.Frontends, f.Name, p, a.Options); err != nil {
+	so := SectionObject{
+		Object:          &f.FrontendBase,
+		Section:         parser.Frontends,
+		Name:            f.Name,
+		Parser:          p,
+		Options:         a.Options,
+		CheckCapability: a.CheckCapability,
+	}
+	if err = so.CreateEditSection(); err != nil {
 		return a.HandleError(f.Name, "", "", a.TID, a.TID == "", err)
 	}
 	for _, bind := range f.Binds {
@@ -18,7 +18,6 @@ package configuration
 import (
 	"strconv"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
 	"github.com/haproxytech/client-native/v6/models"
@@ -51,7 +50,7 @@ func (c *client) GetStructuredGlobalConfiguration(transactionID string) (int64,
 // mandatory. Returns error on fail, nil on success.
 func (c *client) PushStructuredGlobalConfiguration(data *models.Global, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
 	"github.com/haproxytech/client-native/v6/models"
@@ -117,7 +116,7 @@ func (c *client) GetStructuredHealthchecks(transactionID string) (int64, models.
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditStructuredHealthcheck(name string, data *models.HealthCheck, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -152,7 +151,7 @@ func (c *client) EditStructuredHealthcheck(name string, data *models.HealthCheck
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateStructuredHealthcheck(data *models.HealthCheck, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
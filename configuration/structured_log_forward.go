@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/configuration/options"
 	"github.com/haproxytech/client-native/v6/models"
@@ -77,7 +76,7 @@ func (c *client) GetStructuredLogForwards(transactionID string) (int64, models.L
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditStructuredLogForward(name string, data *models.LogForward, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -112,7 +111,7 @@ func (c *client) EditStructuredLogForward(name string, data *models.LogForward,
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateStructuredLogForward(data *models.LogForward, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
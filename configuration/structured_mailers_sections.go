@@ -18,7 +18,6 @@ package configuration
 import (
 	"fmt"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/models"
 )
@@ -75,7 +74,7 @@ func (c *client) GetStructuredMailersSections(transactionID string) (int64, mode
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditStructuredMailersSection(name string, data *models.MailersSection, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -110,7 +109,7 @@ func (c *client) EditStructuredMailersSection(name string, data *models.MailersS
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateStructuredMailersSection(data *models.MailersSection, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -16,7 +16,6 @@
 package configuration
 
 import (
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/models"
 )
@@ -44,7 +43,7 @@ func (c *client) GetStructuredTraces(transactionID string) (int64, *models.Trace
 
 func (c *client) PushStructuredTraces(data *models.Traces, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
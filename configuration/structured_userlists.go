@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"sort"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/models"
 )
@@ -75,7 +74,7 @@ func (c *client) GetStructuredUserLists(transactionID string) (int64, models.Use
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateStructuredUserList(data *models.Userlist, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
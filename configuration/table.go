@@ -19,7 +19,6 @@ import (
 	"errors"
 	"fmt"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -103,7 +102,7 @@ func (c *client) DeleteTable(name string, peerSection string, transactionID stri
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateTable(peerSection string, data *models.Table, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -130,7 +129,7 @@ func (c *client) CreateTable(peerSection string, data *models.Table, transaction
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditTable(name string, peerSection string, data *models.Table, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
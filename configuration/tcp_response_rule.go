@@ -20,7 +20,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/common"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
@@ -118,7 +117,7 @@ func (c *client) DeleteTCPResponseRule(id int64, parentType, parentName string,
 // mandatory. Returns error on fail, nil on success.
 func (c *client) CreateTCPResponseRule(id int64, parentType, parentName string, data *models.TCPResponseRule, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -148,7 +147,7 @@ func (c *client) CreateTCPResponseRule(id int64, parentType, parentName string,
 // mandatory. Returns error on fail, nil on success.
 func (c *client) EditTCPResponseRule(id int64, parentType, parentName string, data *models.TCPResponseRule, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -182,7 +181,7 @@ func (c *client) EditTCPResponseRule(id int64, parentType, parentName string, da
 // Returns error on fail, nil on success.
 func (c *client) ReplaceTCPResponseRules(parentType, parentName string, data models.TCPResponseRules, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
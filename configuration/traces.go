@@ -20,7 +20,7 @@ import (
 	"fmt"
 	"strings"
 
-	strfmt "github.com/go-openapi/strfmt"
+	"github.com/haproxytech/client-native/v6/capabilities"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/common"
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
@@ -58,8 +58,12 @@ func (c *client) GetTraces(transactionID string) (int64, *models.Traces, error)
 }
 
 func (c *client) CreateTraces(data *models.Traces, transactionID string, version int64) error {
+	if err := c.CheckCapability(capabilities.Keyword, capabilities.KeywordTraces); err != nil {
+		return err
+	}
+
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -88,7 +92,7 @@ func (c *client) CreateTraces(data *models.Traces, transactionID string, version
 
 func (c *client) EditTraces(data *models.Traces, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -123,7 +127,7 @@ func (c *client) DeleteTraces(transactionID string, version int64) error {
 // Add an entry to the traces section.
 func (c *client) CreateTraceEntry(data *models.TraceEntry, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -158,7 +162,7 @@ func (c *client) CreateTraceEntry(data *models.TraceEntry, transactionID string,
 // Delete a trace entry from the traces section.
 func (c *client) DeleteTraceEntry(data *models.TraceEntry, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -30,8 +30,10 @@ import (
 	parser_errors "github.com/haproxytech/client-native/v6/config-parser/errors"
 	parser_options "github.com/haproxytech/client-native/v6/config-parser/options"
 	spoe "github.com/haproxytech/client-native/v6/config-parser/spoe"
+	"github.com/pmezard/go-difflib/difflib"
 
 	"github.com/haproxytech/client-native/v6/configuration/options"
+	"github.com/haproxytech/client-native/v6/diagnostics"
 	"github.com/haproxytech/client-native/v6/models"
 )
 
@@ -43,6 +45,7 @@ type TransactionClient interface {
 	IncrementTransactionVersion(transactionID string) error
 	LoadData(filename string) error
 	Save(transactionFile, transactionID string) error
+	Render(transactionID string) (string, error)
 	HasParser(transactionID string) bool
 	GetParserTransactions() models.Transactions
 	GetFailedParserTransactionVersion(transactionID string) (int64, error)
@@ -50,6 +53,17 @@ type TransactionClient interface {
 	SetValidateConfigFiles(before, after []string)
 }
 
+// fileRenderer is implemented by the transaction clients writing the configuration to several files
+type fileRenderer interface {
+	renderFiles(transactionID string) ([]renderedFile, error)
+}
+
+// commitJournal is implemented by the transaction clients keeping a journal of commits
+type commitJournal interface {
+	prepareJournalEntry(transactionID string) *JournalEntry
+	appendJournalEntry(entry *JournalEntry)
+}
+
 // transactionCleanerHandler is just a type dealing with a transaction file:
 // actually implemented moving to the `failed` or `outdated` folder.
 type transactionCleanerHandler func(transactionId, configurationFile string)
@@ -60,15 +74,22 @@ type Transaction struct {
 	TransactionClient   TransactionClient
 	mu                  sync.Mutex
 	noNamedDefaultsFrom bool
+	metadata            map[string]*transactionMetadata
+	metadataMu          sync.Mutex
 }
 
 type Transactions interface {
 	GetTransactions(status string) (*models.Transactions, error)
 	GetTransaction(transactionID string) (*models.Transaction, error)
 	StartTransaction(version int64) (*models.Transaction, error)
+	StartTransactionWithOwner(version int64, owner string) (*models.Transaction, error)
 	DeleteTransaction(transactionID string) error
 	CommitTransaction(transactionID string) (*models.Transaction, error)
+	// CommitTransactionWithDiagnostics commits a transaction, returning the warnings of the configuration check.
+	CommitTransactionWithDiagnostics(transactionID string) (*models.Transaction, diagnostics.Diagnostics, error)
 	MarkTransactionOutdated(transactionID string) (err error)
+	GetTransactionDiff(transactionID string) (string, error)
+	ExpireTransactions() (models.Transactions, error)
 	SetValidateConfigFiles(before, after []string)
 }
 
@@ -86,19 +107,28 @@ func (t *Transaction) GetTransaction(transactionID string) (*models.Transaction,
 		if err != nil {
 			return nil, NewConfError(ErrTransactionDoesNotExist, fmt.Sprintf("transaction %v does not exist", transactionID))
 		}
-		return t.parseTransactionFile(tFile), nil
+		m := t.parseTransactionFile(tFile)
+		t.setTransactionMetadata(m)
+		return m, nil
 	}
 	v, _ := t.TransactionClient.GetVersion(transactionID)
 
-	return &models.Transaction{ID: transactionID, Status: models.TransactionStatusInProgress, Version: v}, nil
+	m := &models.Transaction{ID: transactionID, Status: models.TransactionStatusInProgress, Version: v}
+	t.setTransactionMetadata(m)
+	return m, nil
 }
 
 // StartTransaction starts a new empty lbctl transaction
 func (t *Transaction) StartTransaction(version int64) (*models.Transaction, error) {
-	return t.startTransaction(version, false)
+	return t.startTransaction(version, false, "")
+}
+
+// StartTransactionWithOwner starts a new empty transaction, recording the user or client who started it
+func (t *Transaction) StartTransactionWithOwner(version int64, owner string) (*models.Transaction, error) {
+	return t.startTransaction(version, false, owner)
 }
 
-func (t *Transaction) startTransaction(version int64, skipVersion bool) (*models.Transaction, error) {
+func (t *Transaction) startTransaction(version int64, skipVersion bool, owner string) (*models.Transaction, error) {
 	m := &models.Transaction{}
 
 	if !skipVersion {
@@ -130,33 +160,106 @@ func (t *Transaction) startTransaction(version int64, skipVersion bool) (*models
 		}
 		return nil, err
 	}
+	t.recordTransaction(m.ID, owner)
+	t.setTransactionMetadata(m)
 	return m, nil
 }
 
+// GetTransactionDiff returns a unified diff of the changes a transaction will make
+// to the configuration file once committed. The version and md5 hash header lines are ignored.
+// With several configuration files, the diff of each changed file follows the one of the main file.
+func (t *Transaction) GetTransactionDiff(transactionID string) (string, error) {
+	if !t.TransactionClient.HasParser(transactionID) {
+		return "", NewConfError(ErrTransactionDoesNotExist, fmt.Sprintf("transaction %v does not exist", transactionID))
+	}
+	renderer, ok := t.TransactionClient.(fileRenderer)
+	if len(t.ConfigurationFiles) == 0 || !ok {
+		transactionData, err := t.TransactionClient.Render(transactionID)
+		if err != nil {
+			return "", err
+		}
+		return fileDiff(t.ConfigurationFile, transactionID, transactionData)
+	}
+
+	files, err := renderer.renderFiles(transactionID)
+	if err != nil {
+		return "", err
+	}
+	var result strings.Builder
+	for _, file := range files {
+		diff, err := fileDiff(file.path, transactionID, file.data)
+		if err != nil {
+			return "", err
+		}
+		result.WriteString(diff)
+	}
+	return result.String(), nil
+}
+
+// fileDiff returns the unified diff between a configuration file on disk and its content in a transaction,
+// a missing file being empty
+func fileDiff(file, transactionID, transactionData string) (string, error) {
+	configData, err := os.ReadFile(file)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", NewConfError(ErrCannotReadConfFile, err.Error())
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        withoutMetadataLines(string(configData)),
+		B:        withoutMetadataLines(transactionData),
+		FromFile: file,
+		ToFile:   fmt.Sprintf("%s (transaction %s)", file, transactionID),
+		Context:  3,
+	})
+	if err != nil {
+		return "", NewConfError(ErrGeneralError, err.Error())
+	}
+	return diff, nil
+}
+
+// withoutMetadataLines splits data in lines, without the lines of the
+// version and md5 hash which change on every commit
+func withoutMetadataLines(data string) []string {
+	lines := difflib.SplitLines(data)
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if strings.HasPrefix(line, "# _version") || strings.HasPrefix(line, "# _md5hash") {
+			continue
+		}
+		result = append(result, line)
+	}
+	return result
+}
+
 // CommitTransaction commits a transaction by id.
 func (t *Transaction) CommitTransaction(transactionID string) (*models.Transaction, error) {
+	m, _, err := t.commitTransaction(transactionID, false)
+	return m, err
+}
+
+// CommitTransactionWithDiagnostics commits a transaction by id, returning the warnings of the configuration check.
+func (t *Transaction) CommitTransactionWithDiagnostics(transactionID string) (*models.Transaction, diagnostics.Diagnostics, error) {
 	return t.commitTransaction(transactionID, false)
 }
 
 // CommitTransaction commits a transaction by id.
-func (t *Transaction) commitTransaction(transactionID string, skipVersion bool) (*models.Transaction, error) {
+func (t *Transaction) commitTransaction(transactionID string, skipVersion bool) (*models.Transaction, diagnostics.Diagnostics, error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 	// do a version check before committing
 	version, err := t.TransactionClient.GetVersion("")
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	tVersion, err := t.TransactionClient.GetVersion(transactionID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if !skipVersion {
 		if tVersion != version {
 			t.failTransaction(transactionID, t.writeOutdatedTransaction)
-			return nil, NewConfError(ErrVersionMismatch, fmt.Sprintf("version mismatch, transaction version: %v, configured version: %v", tVersion, version))
+			return nil, nil, NewConfError(ErrVersionMismatch, fmt.Sprintf("version mismatch, transaction version: %v, configured version: %v", tVersion, version))
 		}
 	}
 
@@ -164,79 +267,98 @@ func (t *Transaction) commitTransaction(transactionID string, skipVersion bool)
 	if !t.PersistentTransactions {
 		err = t.createTransactionFiles(transactionID)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	transactionFile, err := t.GetTransactionFile(transactionID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Always save parsed transaction file in order to validate the exact same
 	// configuration that will be deployed
 	if err := t.TransactionClient.Save(transactionFile, transactionID); err != nil {
 		t.failTransaction(transactionID, t.writeFailedTransaction)
-		return nil, NewConfError(ErrErrorChangingConfig, err.Error())
+		return nil, nil, NewConfError(ErrErrorChangingConfig, err.Error())
 	}
 
 	if !skipVersion {
 		if err := t.TransactionClient.IncrementTransactionVersion(transactionID); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	if err := t.checkTransactionFile(transactionID); err != nil {
+	diags, err := t.checkTransactionFile(transactionID)
+	if err != nil {
 		t.failTransaction(transactionID, t.writeFailedTransaction)
-		return nil, err
+		return nil, nil, err
+	}
+
+	var journalEntry *JournalEntry
+	journal, journaled := t.TransactionClient.(commitJournal)
+	if journaled {
+		journalEntry = journal.prepareJournalEntry(transactionID)
 	}
 
 	// Fail backing up and cleaning backups silently
+	var backupFile string
 	if t.BackupsNumber > 0 {
-		t.backupCfgAndCleanup(version)
+		backupFile = t.backupCfgAndCleanup(version)
 	}
 
 	if err := t.TransactionClient.Save(t.ConfigurationFile, transactionID); err != nil {
 		t.failTransaction(transactionID, t.writeFailedTransaction)
-		return nil, err
+		return nil, nil, err
 	}
 
 	_ = t.deleteTransactionFiles(transactionID)
 
 	if err := t.TransactionClient.CommitParser(transactionID); err != nil {
 		_ = t.TransactionClient.LoadData(t.ConfigurationFile)
-		return nil, err
+		return nil, nil, err
+	}
+	t.forgetTransaction(transactionID)
+
+	// journaling failures don't fail the commit, they are reported by JournalErrors
+	if journalEntry != nil {
+		journalEntry.BackupFile = backupFile
+		journal.appendJournalEntry(journalEntry)
 	}
 
-	return &models.Transaction{ID: transactionID, Version: tVersion, Status: "success"}, nil
+	return &models.Transaction{ID: transactionID, Version: tVersion, Status: "success"}, diags, nil
 }
 
-func (t *Transaction) backupCfgAndCleanup(version int64) {
+// backupCfgAndCleanup returns the backup file, empty if it could not be saved
+func (t *Transaction) backupCfgAndCleanup(version int64) string {
 	backupFilePrefix := filepath.Join(t.BackupsDir, filepath.Base(t.ConfigurationFile))
 
 	backupConfFile := fmt.Sprintf("%v.%v", backupFilePrefix, strconv.Itoa(int(version)))
-	_ = t.TransactionClient.Save(backupConfFile, "")
+	if err := t.TransactionClient.Save(backupConfFile, ""); err != nil {
+		backupConfFile = ""
+	}
 	backupToDel := fmt.Sprintf("%v.%v", backupFilePrefix, strconv.Itoa(int(version)-t.BackupsNumber))
 	os.Remove(backupToDel)
+	return backupConfFile
 }
 
-func (t *Transaction) checkTransactionFile(transactionID string) error {
+func (t *Transaction) checkTransactionFile(transactionID string) (diagnostics.Diagnostics, error) {
 	// check only against HAProxy file
 	_, ok := t.TransactionClient.(*client)
 	if !ok {
-		return nil
+		return nil, nil
 	}
 	// there are some cases when we don't want to validate a config file,
 	// such as if want to use different HAProxy (community, enterprise, aloha)
 	// where different options are supported.
 	// By disabling validation we can still use DPAPI
 	if t.SkipConfigurationFileValidation {
-		return nil
+		return nil, nil
 	}
 
 	transactionFile, err := t.GetTransactionFile(transactionID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	return checkHaproxyConfiguration(t.ConfigurationOptions, transactionFile, transactionID)
@@ -313,6 +435,7 @@ func (t *Transaction) DeleteTransaction(transactionID string) error {
 			return err
 		}
 	}
+	t.forgetTransaction(transactionID)
 
 	// Parsers from transactions with `failed` status are deleted when CommitParser implementation is invoked.
 	// Because of that, we should not try to delete already deleted parser.
@@ -382,6 +505,12 @@ func (t *Transaction) parseTransactions(status string) (*models.Transactions, er
 					return nil, err
 				}
 			}
+		case status == models.TransactionStatusExpired:
+			if f.Name() == models.TransactionStatusExpired {
+				if err = readDirAndAppend(f); err != nil {
+					return nil, err
+				}
+			}
 		case f.IsDir() && status == "":
 			if err = readDirAndAppend(f); err != nil {
 				return nil, err
@@ -395,6 +524,9 @@ func (t *Transaction) parseTransactions(status string) (*models.Transactions, er
 			transactions = append(transactions, pt...)
 		}
 	}
+	for _, tr := range transactions {
+		t.setTransactionMetadata(tr)
+	}
 	return &transactions, nil
 }
 
@@ -409,6 +541,8 @@ func (t *Transaction) parseTransactionFile(filePath string) *models.Transaction
 			status = models.TransactionStatusFailed
 		case models.TransactionStatusOutdated:
 			status = models.TransactionStatusOutdated
+		case models.TransactionStatusExpired:
+			status = models.TransactionStatusExpired
 		}
 	}
 
@@ -446,6 +580,11 @@ func (t *Transaction) createTransactionFiles(transactionID string) error {
 	if err != nil {
 		return err
 	}
+	// the configuration the transaction is started from is kept to rebase it,
+	// failing silently like backups
+	if data, err := os.ReadFile(confFilePath); err == nil {
+		_ = os.WriteFile(baseFile(confFilePath), data, 0o644) //nolint:gosec
+	}
 
 	return nil
 }
@@ -462,6 +601,8 @@ func (t *Transaction) deleteTransactionFiles(transactionID string) error {
 			return err
 		}
 	}
+	_ = os.Remove(metadataFile(confFilePath))
+	_ = os.Remove(baseFile(confFilePath))
 	return nil
 }
 
@@ -486,6 +627,10 @@ func (t *Transaction) GetTransactionFile(transactionID string) (string, error) {
 	if _, err := os.Stat(fPath); err == nil {
 		return fPath, nil
 	}
+	fPath = filepath.Join(t.TransactionDir, models.TransactionStatusExpired, transactionFileName)
+	if _, err := os.Stat(fPath); err == nil {
+		return fPath, nil
+	}
 	// Return in progress transaction file if exists, else empty string
 	fPath = filepath.Join(t.TransactionDir, transactionFileName)
 	if _, err := os.Stat(fPath); err == nil {
@@ -515,6 +660,7 @@ func (t *Transaction) getBackupFile(version int64) (string, error) {
 }
 
 func (t *Transaction) failTransaction(transactionID string, txHandler transactionCleanerHandler) {
+	t.forgetTransaction(transactionID)
 	configFile, err := t.GetTransactionFile(transactionID)
 	if err != nil {
 		return
@@ -525,6 +671,7 @@ func (t *Transaction) failTransaction(transactionID string, txHandler transactio
 	} else {
 		txHandler(transactionID, configFile)
 	}
+	t.moveTransactionMetadata(transactionID, configFile)
 	_ = t.TransactionClient.DeleteParser(transactionID)
 }
 
@@ -580,6 +727,7 @@ func moveFile(src, dest string) error {
 }
 
 func (t *Transaction) SaveData(prsr any, tID string, commitImplicit bool) error {
+	t.touchTransaction(tID)
 	if t.PersistentTransactions {
 		tFile, err := t.GetTransactionFile(tID)
 		if err != nil {
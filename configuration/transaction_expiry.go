@@ -0,0 +1,261 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/haproxytech/client-native/v6/configuration/options"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+// transactionMetadata is kept in memory for the transactions in progress. With persistent
+// transactions it is also saved next to the transaction file, the modification time of
+// the transaction file being used as last modification time after a restart.
+type transactionMetadata struct {
+	createdAt    time.Time
+	lastModified time.Time
+	owner        string
+	reason       string
+}
+
+// savedTransactionMetadata is the metadata saved next to a transaction file
+type savedTransactionMetadata struct {
+	CreatedAt time.Time `json:"created_at"`
+	Owner     string    `json:"owner,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+}
+
+func (t *Transaction) recordTransaction(transactionID, owner string) {
+	now := time.Now()
+	m := &transactionMetadata{createdAt: now, lastModified: now, owner: owner}
+	t.metadataMu.Lock()
+	defer t.metadataMu.Unlock()
+	if t.metadata == nil {
+		t.metadata = make(map[string]*transactionMetadata)
+	}
+	t.metadata[transactionID] = m
+	t.saveTransactionMetadata(transactionID, m)
+}
+
+func (t *Transaction) touchTransaction(transactionID string) {
+	t.metadataMu.Lock()
+	defer t.metadataMu.Unlock()
+	if m := t.activeTransactionMetadata(transactionID); m != nil {
+		m.lastModified = time.Now()
+	}
+}
+
+// activeTransactionMetadata returns the metadata of a transaction in progress, loaded from
+// its file when it was started before a restart, nil if there is none. metadataMu must be held.
+func (t *Transaction) activeTransactionMetadata(transactionID string) *transactionMetadata {
+	if m, ok := t.metadata[transactionID]; ok {
+		return m
+	}
+	m, ok := t.loadTransactionMetadata(transactionID)
+	if !ok {
+		return nil
+	}
+	if t.metadata == nil {
+		t.metadata = make(map[string]*transactionMetadata)
+	}
+	t.metadata[transactionID] = m
+	return m
+}
+
+// transactionMetadataOf returns a copy of the metadata of a transaction, from memory or from its file
+func (t *Transaction) transactionMetadataOf(transactionID string) (transactionMetadata, bool) {
+	t.metadataMu.Lock()
+	defer t.metadataMu.Unlock()
+	if m, ok := t.metadata[transactionID]; ok {
+		return *m, true
+	}
+	if m, ok := t.loadTransactionMetadata(transactionID); ok {
+		return *m, true
+	}
+	return transactionMetadata{}, false
+}
+
+// metadataFile returns the file the metadata of a transaction is saved in, hidden next to the
+// transaction file so that it is not listed as a transaction
+func metadataFile(transactionFile string) string {
+	return filepath.Join(filepath.Dir(transactionFile), "."+filepath.Base(transactionFile)+".meta")
+}
+
+// baseFile returns the file the configuration a transaction was started from is saved in,
+// hidden next to the transaction file
+func baseFile(transactionFile string) string {
+	return filepath.Join(filepath.Dir(transactionFile), "."+filepath.Base(transactionFile)+".base")
+}
+
+// saveTransactionMetadata saves the metadata of a persistent transaction, failing silently like backups
+func (t *Transaction) saveTransactionMetadata(transactionID string, m *transactionMetadata) {
+	if !t.PersistentTransactions {
+		return
+	}
+	tFile, err := t.GetTransactionFile(transactionID)
+	if err != nil {
+		return
+	}
+	data, err := json.Marshal(savedTransactionMetadata{CreatedAt: m.createdAt, Owner: m.owner, Reason: m.reason})
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(metadataFile(tFile), data, 0o644) //nolint:gosec
+}
+
+func (t *Transaction) loadTransactionMetadata(transactionID string) (*transactionMetadata, bool) {
+	if !t.PersistentTransactions {
+		return nil, false
+	}
+	tFile, err := t.GetTransactionFile(transactionID)
+	if err != nil {
+		return nil, false
+	}
+	data, err := os.ReadFile(metadataFile(tFile))
+	if err != nil {
+		return nil, false
+	}
+	var saved savedTransactionMetadata
+	if err = json.Unmarshal(data, &saved); err != nil {
+		return nil, false
+	}
+	return &transactionMetadata{createdAt: saved.CreatedAt, owner: saved.Owner, reason: saved.Reason}, true
+}
+
+// moveTransactionMetadata moves the metadata and base files of a transaction along with its
+// transaction file, or removes them when the transaction file was removed
+func (t *Transaction) moveTransactionMetadata(transactionID, transactionFile string) {
+	tFile, err := t.GetTransactionFile(transactionID)
+	for _, file := range []func(string) string{metadataFile, baseFile} {
+		switch {
+		case err != nil:
+			_ = os.Remove(file(transactionFile))
+		case tFile != transactionFile:
+			_ = moveFile(file(transactionFile), file(tFile))
+		}
+	}
+}
+
+func (t *Transaction) forgetTransaction(transactionID string) {
+	t.metadataMu.Lock()
+	defer t.metadataMu.Unlock()
+	delete(t.metadata, transactionID)
+}
+
+// setTransactionMetadata sets the creation and modification times and the owner of a transaction
+func (t *Transaction) setTransactionMetadata(m *models.Transaction) {
+	if meta, ok := t.transactionMetadataOf(m.ID); ok {
+		m.CreatedAt = strfmt.DateTime(meta.createdAt)
+		m.LastModified = strfmt.DateTime(meta.lastModified)
+		m.Owner = meta.owner
+	}
+	if !time.Time(m.LastModified).IsZero() {
+		return
+	}
+	if lastModified, found := t.transactionFileModTime(m.ID); found {
+		m.LastModified = strfmt.DateTime(lastModified)
+	}
+}
+
+func (t *Transaction) transactionFileModTime(transactionID string) (time.Time, bool) {
+	if !t.PersistentTransactions {
+		return time.Time{}, false
+	}
+	tFile, err := t.GetTransactionFile(transactionID)
+	if err != nil {
+		return time.Time{}, false
+	}
+	info, err := os.Stat(tFile)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return info.ModTime(), true
+}
+
+// ExpireTransactions expires the in progress transactions which were not changed for longer than
+// the TransactionTTL, and returns them. Expired transactions are moved to the `expired` folder,
+// or removed with SkipFailedTransactions.
+func (t *Transaction) ExpireTransactions() (models.Transactions, error) {
+	if t.TransactionTTL <= 0 {
+		return nil, nil
+	}
+	transactions, err := t.GetTransactions(models.TransactionStatusInProgress)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	expired := models.Transactions{}
+	deadline := time.Now().Add(-t.TransactionTTL)
+	for _, tr := range *transactions {
+		if tr.Status != models.TransactionStatusInProgress {
+			continue
+		}
+		lastModified := time.Time(tr.LastModified)
+		if lastModified.IsZero() || lastModified.After(deadline) {
+			continue
+		}
+		// committed or deleted in the meantime
+		if !t.TransactionClient.HasParser(tr.ID) {
+			continue
+		}
+		if t.PersistentTransactions {
+			t.failTransaction(tr.ID, t.writeExpiredTransaction)
+		} else {
+			_ = t.TransactionClient.DeleteParser(tr.ID)
+			t.forgetTransaction(tr.ID)
+		}
+		tr.Status = models.TransactionStatusExpired
+		expired = append(expired, tr)
+	}
+	return expired, nil
+}
+
+// sweepTransactions expires transactions in the background until ctx is done
+func (t *Transaction) sweepTransactions(ctx context.Context) {
+	interval := t.TransactionSweepInterval
+	if interval <= 0 {
+		interval = options.DefaultTransactionSweepInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, _ = t.ExpireTransactions()
+		}
+	}
+}
+
+func (t *Transaction) writeExpiredTransaction(transactionID, configFile string) {
+	expiredDir := filepath.Join(t.TransactionDir, models.TransactionStatusExpired)
+	if _, err := os.Stat(expiredDir); os.IsNotExist(err) {
+		_ = os.Mkdir(expiredDir, 0o755)
+	}
+	expiredConfigFile := t.getTransactionFile(transactionID, models.TransactionStatusExpired)
+	if err := moveFile(configFile, expiredConfigFile); err != nil {
+		_ = os.Remove(configFile)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/haproxytech/client-native/v6/configuration/options"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+func TestGetTransactionDiff(t *testing.T) {
+	c, cfgFile := newDiffTestClient(t)
+	// write the configuration as the parser renders it, so that only the changes show up
+	if err := os.WriteFile(cfgFile, []byte(c.Parser().String()), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := c.StartTransaction(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := c.GetTransactionDiff(tx.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != "" {
+		t.Errorf("diff of an empty transaction = %q, want empty", diff)
+	}
+
+	if err = c.DeleteBackend("be_old", tx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.CreateBackend(&models.Backend{BackendBase: models.BackendBase{Name: "be_new", Mode: "http"}}, tx.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	diff, err = c.GetTransactionDiff(tx.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"--- " + cfgFile + "\n",
+		"+++ " + cfgFile + " (transaction " + tx.ID + ")\n",
+		"-backend be_old",
+		"+backend be_new\n",
+	} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("diff does not contain %q:\n%s", want, diff)
+		}
+	}
+	if strings.Contains(diff, "_version") || strings.Contains(diff, "_md5hash") {
+		t.Errorf("diff contains metadata lines:\n%s", diff)
+	}
+
+	if _, err = c.GetTransactionDiff("missing"); !errors.Is(err, ErrTransactionDoesNotExist) {
+		t.Errorf("GetTransactionDiff() error = %v, want ErrTransactionDoesNotExist", err)
+	}
+}
+
+func TestExpireTransactions(t *testing.T) {
+	c, _ := newDiffTestClient(t, options.UsePersistentTransactions, options.TransactionTTL(100*time.Millisecond))
+
+	abandoned, err := c.StartTransactionWithOwner(2, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if abandoned.Owner != "alice" || time.Time(abandoned.CreatedAt).IsZero() || time.Time(abandoned.LastModified).IsZero() {
+		t.Errorf("started transaction = %+v, want owner and times", abandoned)
+	}
+	time.Sleep(200 * time.Millisecond)
+
+	active, err := c.StartTransactionWithOwner(2, "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.DeleteBackend("be_old", active.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+	tr, err := c.GetTransaction(active.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !time.Time(tr.LastModified).After(time.Time(tr.CreatedAt)) {
+		t.Errorf("last modified %v not after creation %v", tr.LastModified, tr.CreatedAt)
+	}
+
+	expired, err := c.ExpireTransactions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(expired) != 1 || expired[0].ID != abandoned.ID || expired[0].Status != models.TransactionStatusExpired {
+		t.Fatalf("expired transactions = %+v, want %s", expired, abandoned.ID)
+	}
+
+	tr, err = c.GetTransaction(abandoned.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr.Status != models.TransactionStatusExpired || tr.Owner != "alice" {
+		t.Errorf("expired transaction = %+v, want status expired and owner alice", tr)
+	}
+	transactions, err := c.GetTransactions(models.TransactionStatusExpired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*transactions) != 1 || (*transactions)[0].ID != abandoned.ID {
+		t.Errorf("expired transactions = %+v, want %s", *transactions, abandoned.ID)
+	}
+	if _, err = c.CommitTransaction(active.ID); err != nil {
+		t.Errorf("CommitTransaction() of the active transaction error = %v", err)
+	}
+}
+
+func TestTransactionMetadataRestart(t *testing.T) {
+	c, cfgFile := newDiffTestClient(t, options.UsePersistentTransactions)
+
+	tr, err := c.StartTransactionWithOwner(2, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.SetTransactionReason(tr.ID, "retire be_old"); err != nil {
+		t.Fatal(err)
+	}
+
+	restarted, err := New(context.Background(),
+		options.ConfigurationFile(cfgFile),
+		options.TransactionsDir(filepath.Join(filepath.Dir(cfgFile), "transactions")),
+		options.HAProxyVersion("3.1"),
+		options.SkipConfigurationFileValidation,
+		options.UsePersistentTransactions,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := restarted.GetTransaction(tr.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Owner != "alice" || !time.Time(got.CreatedAt).Equal(time.Time(tr.CreatedAt)) {
+		t.Errorf("transaction after a restart = %+v, want owner alice created at %v", got, tr.CreatedAt)
+	}
+	transactions, err := restarted.GetTransactions("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*transactions) != 1 {
+		t.Errorf("transactions = %+v, want %s only", *transactions, tr.ID)
+	}
+
+	// failed transactions are forgotten, their metadata kept with their file
+	if err = restarted.DeleteBackend("be_old", "", 2); err != nil {
+		t.Fatal(err)
+	}
+	if err = restarted.MarkTransactionOutdated(tr.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := restarted.(*client).metadata[tr.ID]; ok {
+		t.Error("metadata of the outdated transaction kept in memory")
+	}
+	if got, err = restarted.GetTransaction(tr.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != models.TransactionStatusOutdated || got.Owner != "alice" {
+		t.Errorf("outdated transaction = %+v, want owner alice", got)
+	}
+	if err = restarted.DeleteTransaction(tr.ID); err != nil {
+		t.Fatal(err)
+	}
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(cfgFile), "transactions", "*", ".*.meta"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("metadata files left after the deletion: %v", files)
+	}
+}
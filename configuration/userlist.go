@@ -18,7 +18,6 @@ package configuration
 import (
 	"fmt"
 
-	strfmt "github.com/go-openapi/strfmt"
 	parser "github.com/haproxytech/client-native/v6/config-parser"
 	"github.com/haproxytech/client-native/v6/config-parser/common"
 	"github.com/haproxytech/client-native/v6/config-parser/types"
@@ -95,7 +94,7 @@ func (c *client) DeleteUserList(name string, transactionID string, version int64
 // Returns error on fail, nil on success.
 func (c *client) CreateUserList(data *models.Userlist, transactionID string, version int64) error {
 	if c.UseModelsValidation {
-		validationErr := data.Validate(strfmt.Default)
+		validationErr := c.validateModel(data, transactionID)
 		if validationErr != nil {
 			return NewConfError(ErrValidationError, validationErr.Error())
 		}
@@ -0,0 +1,145 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package clientnative
+
+import (
+	"github.com/haproxytech/client-native/v6/configuration"
+	"github.com/haproxytech/client-native/v6/models"
+	"github.com/haproxytech/client-native/v6/runtime"
+)
+
+// defaultHTTPReuse is the http-reuse mode used by HAProxy when none is configured
+const defaultHTTPReuse = models.BackendBaseHTTPReuseSafe
+
+// ServerConnPoolUsage correlates the connection pool of a server in the running HAProxy
+// with the connection reuse parameters configured for it
+type ServerConnPoolUsage struct {
+	// Pool is the runtime state of the pool, nil when the server is not running
+	Pool *models.ServerConnPool
+	// PoolMaxConn, PoolLowConn, PoolPurgeDelay and MaxReuse are the effective values
+	// from the server, the backend default-server or the defaults section
+	PoolMaxConn    *int64
+	PoolLowConn    *int64
+	PoolPurgeDelay *int64
+	MaxReuse       *int64
+	Backend        string
+	Server         string
+	// HTTPReuse is the effective http-reuse mode of the backend
+	HTTPReuse string
+	// Hints are tuning observations made from the configuration and the runtime counters
+	Hints []string
+}
+
+// GetServerConnPoolUsage returns the connection pool usage of the servers of a backend
+// along with their configured http-reuse and pool parameters
+func GetServerConnPoolUsage(cfg configuration.Configuration, rt runtime.Runtime, backend string) ([]ServerConnPoolUsage, error) {
+	_, be, err := cfg.GetBackend(backend, "")
+	if err != nil {
+		return nil, err
+	}
+	_, servers, err := cfg.GetServers(configuration.BackendParentName, backend, "")
+	if err != nil {
+		return nil, err
+	}
+	pools, err := rt.GetServersConnections(backend)
+	if err != nil {
+		return nil, err
+	}
+
+	var defaults *models.Defaults
+	if be.From != "" {
+		_, defaults, _ = cfg.GetDefaultsSection(be.From, "")
+	} else {
+		_, defaults, _ = cfg.GetDefaultsConfiguration("")
+	}
+
+	httpReuse := be.HTTPReuse
+	if httpReuse == "" && defaults != nil {
+		httpReuse = defaults.HTTPReuse
+	}
+	if httpReuse == "" {
+		httpReuse = defaultHTTPReuse
+	}
+
+	runtimePools := make(map[string]*models.ServerConnPool, len(pools))
+	for _, pool := range pools {
+		if pool != nil && pool.Backend == backend {
+			runtimePools[pool.Name] = pool
+		}
+	}
+
+	paramSources := []*models.DefaultServer{be.DefaultServer}
+	if defaults != nil {
+		paramSources = append(paramSources, defaults.DefaultServer)
+	}
+
+	result := make([]ServerConnPoolUsage, 0, len(servers))
+	for _, server := range servers {
+		usage := ServerConnPoolUsage{
+			Backend:   backend,
+			Server:    server.Name,
+			HTTPReuse: httpReuse,
+			Pool:      runtimePools[server.Name],
+		}
+		usage.PoolMaxConn = server.PoolMaxConn
+		usage.PoolLowConn = server.PoolLowConn
+		usage.PoolPurgeDelay = server.PoolPurgeDelay
+		usage.MaxReuse = server.MaxReuse
+		for _, ds := range paramSources {
+			if ds == nil {
+				continue
+			}
+			usage.PoolMaxConn = firstInt64P(usage.PoolMaxConn, ds.PoolMaxConn)
+			usage.PoolLowConn = firstInt64P(usage.PoolLowConn, ds.PoolLowConn)
+			usage.PoolPurgeDelay = firstInt64P(usage.PoolPurgeDelay, ds.PoolPurgeDelay)
+			usage.MaxReuse = firstInt64P(usage.MaxReuse, ds.MaxReuse)
+		}
+		usage.Hints = connPoolHints(usage)
+		result = append(result, usage)
+	}
+	return result, nil
+}
+
+func firstInt64P(values ...*int64) *int64 {
+	for _, v := range values {
+		if v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+func connPoolHints(usage ServerConnPoolUsage) []string {
+	var hints []string
+	if usage.HTTPReuse == models.BackendBaseHTTPReuseNever && usage.PoolMaxConn != nil && *usage.PoolMaxConn != 0 {
+		hints = append(hints, "pool-max-conn has no effect with http-reuse never")
+	}
+	if usage.PoolMaxConn != nil && *usage.PoolMaxConn == 0 && usage.HTTPReuse != models.BackendBaseHTTPReuseNever {
+		hints = append(hints, "idle connections are disabled by pool-max-conn 0, connections cannot be reused")
+	}
+	pool := usage.Pool
+	if pool == nil {
+		return hints
+	}
+	if pool.IdleLimit != nil && *pool.IdleLimit > 0 && pool.IdleCur != nil && *pool.IdleCur >= *pool.IdleLimit {
+		hints = append(hints, "idle pool is full, raising pool-max-conn would keep more connections for reuse")
+	}
+	if pool.UnsafeIdle != nil && pool.SafeIdle != nil && *pool.UnsafeIdle > 0 && *pool.SafeIdle == 0 &&
+		usage.HTTPReuse == models.BackendBaseHTTPReuseSafe {
+		hints = append(hints, "only unsafe idle connections are available, http-reuse aggressive or always would reuse them for first requests")
+	}
+	return hints
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package clientnative
+
+import (
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/configuration"
+	"github.com/haproxytech/client-native/v6/misc"
+	"github.com/haproxytech/client-native/v6/models"
+	"github.com/haproxytech/client-native/v6/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+type connPoolConfigurationMock struct {
+	configuration.Configuration
+
+	backend  *models.Backend
+	defaults *models.Defaults
+	servers  models.Servers
+}
+
+func (c *connPoolConfigurationMock) GetBackend(_, _ string) (int64, *models.Backend, error) {
+	return 1, c.backend, nil
+}
+
+func (c *connPoolConfigurationMock) GetDefaultsConfiguration(_ string) (int64, *models.Defaults, error) {
+	return 1, c.defaults, nil
+}
+
+func (c *connPoolConfigurationMock) GetServers(_, _, _ string) (int64, models.Servers, error) {
+	return 1, c.servers, nil
+}
+
+type connPoolRuntimeMock struct {
+	runtime.Runtime
+
+	pools models.ServerConnPools
+}
+
+func (r *connPoolRuntimeMock) GetServersConnections(_ string) (models.ServerConnPools, error) {
+	return r.pools, nil
+}
+
+func TestGetServerConnPoolUsage(t *testing.T) {
+	cfg := &connPoolConfigurationMock{
+		backend: &models.Backend{BackendBase: models.BackendBase{
+			Name:          "be_app",
+			DefaultServer: &models.DefaultServer{ServerParams: models.ServerParams{PoolMaxConn: misc.Int64P(10)}},
+		}},
+		defaults: &models.Defaults{DefaultsBase: models.DefaultsBase{
+			HTTPReuse:     models.BackendBaseHTTPReuseSafe,
+			DefaultServer: &models.DefaultServer{ServerParams: models.ServerParams{PoolPurgeDelay: misc.Int64P(5000)}},
+		}},
+		servers: models.Servers{
+			&models.Server{Name: "srv1"},
+			&models.Server{Name: "srv2", ServerParams: models.ServerParams{PoolMaxConn: misc.Int64P(0)}},
+		},
+	}
+	rt := &connPoolRuntimeMock{pools: models.ServerConnPools{
+		&models.ServerConnPool{Backend: "be_app", Name: "srv1", IdleLimit: misc.Int64P(10), IdleCur: misc.Int64P(10), UnsafeIdle: misc.Int64P(0), SafeIdle: misc.Int64P(10)},
+		&models.ServerConnPool{Backend: "other", Name: "srv2"},
+	}}
+
+	usage, err := GetServerConnPoolUsage(cfg, rt, "be_app")
+	require.NoError(t, err)
+	require.Len(t, usage, 2)
+
+	require.Equal(t, "safe", usage[0].HTTPReuse)
+	require.Equal(t, int64(10), *usage[0].PoolMaxConn)
+	require.Equal(t, int64(5000), *usage[0].PoolPurgeDelay)
+	require.NotNil(t, usage[0].Pool)
+	require.Len(t, usage[0].Hints, 1)
+	require.Contains(t, usage[0].Hints[0], "idle pool is full")
+
+	require.Equal(t, int64(0), *usage[1].PoolMaxConn)
+	require.Nil(t, usage[1].Pool)
+	require.Len(t, usage[1].Hints, 1)
+	require.Contains(t, usage[1].Hints[0], "pool-max-conn 0")
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package diagnostics parses the messages HAProxy emits when it checks or loads
+// its configuration, such as the output of `haproxy -c`, the logs returned by a
+// reload or by `show startup-logs` on the master CLI.
+package diagnostics
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Severity of a diagnostic
+type Severity string
+
+const (
+	SeverityAlert   Severity = "alert"
+	SeverityWarning Severity = "warning"
+	SeverityNotice  Severity = "notice"
+	SeverityDiag    Severity = "diag"
+)
+
+// Diagnostic is a message emitted by HAProxy about its configuration
+type Diagnostic struct {
+	Severity Severity
+	// File and Line locate the configuration line the message is about, when known
+	File string
+	Line int64
+	// Section is the section the message is about, such as "global" or "backend be_app"
+	Section string
+	// Keyword is the configuration keyword the message is about, when known
+	Keyword string
+	Message string
+	// Raw is the log line as emitted by HAProxy
+	Raw string
+}
+
+// Diagnostics is a list of diagnostics
+type Diagnostics []Diagnostic
+
+// Alerts returns the diagnostics with alert severity, which prevent HAProxy from starting
+func (d Diagnostics) Alerts() Diagnostics {
+	return d.BySeverity(SeverityAlert)
+}
+
+// Warnings returns the diagnostics with warning severity
+func (d Diagnostics) Warnings() Diagnostics {
+	return d.BySeverity(SeverityWarning)
+}
+
+// BySeverity returns the diagnostics of a severity
+func (d Diagnostics) BySeverity(severity Severity) Diagnostics {
+	var result Diagnostics
+	for _, diag := range d {
+		if diag.Severity == severity {
+			result = append(result, diag)
+		}
+	}
+	return result
+}
+
+var (
+	// [ALERT]    (1234) : config : ..., or for older versions [ALERT] 123/456789 (1234) : ...
+	headerRe = regexp.MustCompile(`^\[(ALERT|WARNING|NOTICE|DIAG)\]\s*(?:\d+/\d+\s*)?(?:\(\d+\)\s*)?:\s?(.*)$`)
+	// parsing [/etc/haproxy/haproxy.cfg:12] : ..., or [/etc/haproxy/haproxy.cfg:12] : ...
+	locationRe = regexp.MustCompile(`^(?:parsing\s+)?\[([^\]]+):(\d+)\]\s*:\s*(.*)$`)
+	sectionRe  = regexp.MustCompile(`in '([a-z-]+)' section`)
+	proxyRe    = regexp.MustCompile(`(?:proxy|backend|frontend|listen) '([^']+)'`)
+	keywordRe  = regexp.MustCompile(`(?:unknown keyword|keyword|directive|option) '([^']+)'`)
+	quotedRe   = regexp.MustCompile(`^'([^']+)'`)
+)
+
+// summary lines carrying no information about the configuration
+var summaries = []string{ //nolint:gochecknoglobals
+	"Fatal errors found in configuration.",
+	"error(s) found in configuration file",
+	"Error(s) found in configuration file",
+}
+
+// Parse returns the diagnostics of HAProxy output. Lines not starting with a severity
+// are appended to the message of the previous diagnostic.
+func Parse(output string) Diagnostics {
+	var result Diagnostics
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		diag, ok := parseLine(line)
+		if !ok {
+			if len(result) > 0 {
+				last := &result[len(result)-1]
+				last.Message += "\n" + strings.TrimSpace(line)
+				last.Raw += "\n" + line
+			}
+			continue
+		}
+		if isSummary(diag.Message) {
+			continue
+		}
+		result = append(result, diag)
+	}
+	return result
+}
+
+func parseLine(line string) (Diagnostic, bool) {
+	matches := headerRe.FindStringSubmatch(strings.TrimSpace(line))
+	if matches == nil {
+		return Diagnostic{}, false
+	}
+	diag := Diagnostic{
+		Severity: Severity(strings.ToLower(matches[1])),
+		Raw:      line,
+	}
+	message := strings.TrimSpace(matches[2])
+	// message origin, such as "config : " or "haproxy : "
+	if origin, rest, found := strings.Cut(message, " : "); found && !strings.ContainsAny(origin, " []") {
+		message = rest
+	}
+	if loc := locationRe.FindStringSubmatch(message); loc != nil {
+		diag.File = loc[1]
+		diag.Line, _ = strconv.ParseInt(loc[2], 10, 64)
+		message = loc[3]
+	}
+	diag.Message = message
+
+	if m := sectionRe.FindStringSubmatch(message); m != nil {
+		diag.Section = m[1]
+	}
+	if m := proxyRe.FindStringSubmatch(message); m != nil && diag.Section == "" {
+		diag.Section = m[1]
+	}
+	if m := keywordRe.FindStringSubmatch(message); m != nil {
+		diag.Keyword = m[1]
+	} else if m := quotedRe.FindStringSubmatch(message); m != nil && diag.File != "" {
+		diag.Keyword = strings.Fields(m[1])[0]
+	}
+	return diag, true
+}
+
+func isSummary(message string) bool {
+	for _, s := range summaries {
+		if strings.Contains(message, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// sectionKeywords are the keywords starting a configuration section
+var sectionKeywords = map[string]struct{}{ //nolint:gochecknoglobals
+	"global": {}, "defaults": {}, "frontend": {}, "backend": {}, "listen": {}, "userlist": {},
+	"peers": {}, "mailers": {}, "resolvers": {}, "cache": {}, "program": {}, "http-errors": {},
+	"ring": {}, "log-forward": {}, "fcgi-app": {}, "crt-store": {}, "traces": {}, "acme": {},
+	"log-profile": {},
+}
+
+// ResolveSections sets the section of the diagnostics located in a configuration file to
+// the section enclosing their line, such as "backend be_app". Files are read once and
+// diagnostics in files which cannot be read are left unchanged.
+func ResolveSections(diags Diagnostics) {
+	files := map[string][]string{}
+	for i := range diags {
+		d := &diags[i]
+		if d.File == "" || d.Line <= 0 {
+			continue
+		}
+		lines, ok := files[d.File]
+		if !ok {
+			data, err := os.ReadFile(d.File)
+			if err == nil {
+				lines = strings.Split(string(data), "\n")
+			}
+			files[d.File] = lines
+		}
+		if section := sectionAt(lines, d.Line); section != "" {
+			d.Section = section
+		}
+	}
+}
+
+func sectionAt(lines []string, line int64) string {
+	for i := min(int(line), len(lines)) - 1; i >= 0; i-- {
+		l := lines[i]
+		if l == "" || l[0] == ' ' || l[0] == '\t' || l[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(l)
+		if _, ok := sectionKeywords[fields[0]]; ok {
+			if len(fields) > 1 {
+				return fields[0] + " " + fields[1]
+			}
+			return fields[0]
+		}
+	}
+	return ""
+}
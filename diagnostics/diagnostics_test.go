@@ -0,0 +1,90 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package diagnostics
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	output := `[NOTICE]   (1234) : haproxy version is 3.0.5-8e879a5
+[NOTICE]   (1234) : path to executable is /usr/sbin/haproxy
+[WARNING]  (1234) : config : parsing [/etc/haproxy/haproxy.cfg:45] : 'option httplog' not usable with proxy 'stats' (needs 'mode http'). Falling back to 'option tcplog'.
+[ALERT]    (1234) : config : parsing [/etc/haproxy/haproxy.cfg:12] : unknown keyword 'foo' in 'backend' section
+[ALERT]    (1234) : config : proxy 'be_app': unable to find required default_backend: 'missing'.
+  | line continued
+[ALERT] 123/456789 (1234) : parsing [/etc/haproxy/haproxy.cfg:3] : unknown keyword 'bar' in 'global' section
+[ALERT]    (1234) : config : Fatal errors found in configuration.
+`
+	want := Diagnostics{
+		{Severity: SeverityNotice, Message: "haproxy version is 3.0.5-8e879a5"},
+		{Severity: SeverityNotice, Message: "path to executable is /usr/sbin/haproxy"},
+		{
+			Severity: SeverityWarning, File: "/etc/haproxy/haproxy.cfg", Line: 45, Section: "stats", Keyword: "option",
+			Message: "'option httplog' not usable with proxy 'stats' (needs 'mode http'). Falling back to 'option tcplog'.",
+		},
+		{
+			Severity: SeverityAlert, File: "/etc/haproxy/haproxy.cfg", Line: 12, Section: "backend", Keyword: "foo",
+			Message: "unknown keyword 'foo' in 'backend' section",
+		},
+		{
+			Severity: SeverityAlert, Section: "be_app",
+			Message: "proxy 'be_app': unable to find required default_backend: 'missing'.\n| line continued",
+		},
+		{
+			Severity: SeverityAlert, File: "/etc/haproxy/haproxy.cfg", Line: 3, Section: "global", Keyword: "bar",
+			Message: "unknown keyword 'bar' in 'global' section",
+		},
+	}
+	got := Parse(output)
+	for i := range got {
+		got[i].Raw = ""
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() = %+v\nwant %+v", got, want)
+	}
+	if len(got.Alerts()) != 3 || len(got.Warnings()) != 1 {
+		t.Errorf("Alerts() = %d, Warnings() = %d", len(got.Alerts()), len(got.Warnings()))
+	}
+}
+
+func TestResolveSections(t *testing.T) {
+	cfg := filepath.Join(t.TempDir(), "haproxy.cfg")
+	config := `global
+  daemon
+
+backend be_app
+  # comment
+  foo bar
+`
+	if err := os.WriteFile(cfg, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	diags := Diagnostics{
+		{File: cfg, Line: 6, Section: "backend"},
+		{File: cfg, Line: 2},
+		{File: "/nonexistent.cfg", Line: 2, Section: "global"},
+	}
+	ResolveSections(diags)
+	for i, want := range []string{"backend be_app", "global", "global"} {
+		if diags[i].Section != want {
+			t.Errorf("diags[%d].Section = %s, want %s", i, diags[i].Section, want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package misc
+
+import (
+	"strings"
+)
+
+// ExpandEnvironment replaces the environment variables of a configuration line the way HAProxy does:
+// only within double quotes, as $VAR, ${VAR}, ${VAR[*]}, ${VAR-default} when VAR is not set or
+// ${VAR:-default} when VAR is not set or empty. Quotes are kept and comments are left as is.
+func ExpandEnvironment(line string, lookup func(name string) (string, bool)) string {
+	return expandEnvironment(line, lookup, false)
+}
+
+// ExpandEnvironmentUnquoted is ExpandEnvironment removing the quotes around the expanded
+// variables which are not needed anymore, "${ADDR}:80" becoming 127.0.0.1:80
+func ExpandEnvironmentUnquoted(line string, lookup func(name string) (string, bool)) string {
+	return expandEnvironment(line, lookup, true)
+}
+
+func expandEnvironment(line string, lookup func(name string) (string, bool), unquote bool) string {
+	result := make([]byte, 0, len(line))
+	quote := byte(0)
+	// start of the double quoted part in result and if it has variables
+	start := 0
+	expanded := false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && quote != '\'' && i+1 < len(line):
+			result = append(result, c, line[i+1])
+			i++
+			continue
+		case quote == 0 && c == '#':
+			return string(append(result, line[i:]...))
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+			start = len(result)
+			expanded = false
+		case quote != 0 && c == quote:
+			quote = 0
+			if unquote && expanded && len(result) > start+1 && !strings.ContainsAny(string(result[start+1:]), " \t\"'#\\") {
+				result = append(result[:start], result[start+1:]...)
+				continue
+			}
+		case quote == '"' && c == '$':
+			if value, length, ok := expandVariable(line[i+1:], lookup); ok {
+				result = append(result, value...)
+				expanded = true
+				i += length
+				continue
+			}
+		}
+		result = append(result, c)
+	}
+	return string(result)
+}
+
+// expandVariable returns the value of the variable reference at the start of s, after its $,
+// and the length of the reference
+func expandVariable(s string, lookup func(name string) (string, bool)) (string, int, bool) {
+	if !strings.HasPrefix(s, "{") {
+		length := variableNameLength(s)
+		if length == 0 {
+			return "", 0, false
+		}
+		value, _ := lookup(s[:length])
+		return value, length, true
+	}
+
+	end := strings.IndexByte(s, '}')
+	if end < 0 {
+		return "", 0, false
+	}
+	reference := s[1:end]
+	length := variableNameLength(reference)
+	if length == 0 {
+		return "", 0, false
+	}
+	value, set := lookup(reference[:length])
+	switch rest := reference[length:]; {
+	case rest == "" || rest == "[*]":
+	case strings.HasPrefix(rest, ":-"):
+		if value == "" {
+			value = rest[2:]
+		}
+	case strings.HasPrefix(rest, "-"):
+		if !set {
+			value = rest[1:]
+		}
+	default:
+		return "", 0, false
+	}
+	return value, end + 1, true
+}
+
+func variableNameLength(s string) int {
+	length := 0
+	for length < len(s) {
+		c := s[length]
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (length > 0 && c >= '0' && c <= '9') {
+			length++
+			continue
+		}
+		break
+	}
+	return length
+}
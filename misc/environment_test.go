@@ -0,0 +1,77 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package misc
+
+import (
+	"testing"
+)
+
+func TestExpandEnvironment(t *testing.T) {
+	env := map[string]string{"IP": "10.0.0.1", "PORT": "8080", "EMPTY": "", "LIST": "a b"}
+	lookup := func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"Should expand braces", `bind "${IP}:${PORT}"`, `bind "10.0.0.1:8080"`},
+		{"Should expand plain variables", `bind "$IP:80"`, `bind "10.0.0.1:80"`},
+		{"Should not expand unquoted variables", `bind ${IP}:80`, `bind ${IP}:80`},
+		{"Should not expand single quoted variables", `bind '${IP}:80'`, `bind '${IP}:80'`},
+		{"Should not expand comments", `bind "${IP}" # "${PORT}"`, `bind "10.0.0.1" # "${PORT}"`},
+		{"Should expand missing variables to nothing", `server s1 "${MISSING}:80"`, `server s1 ":80"`},
+		{"Should use default of missing variables", `log "${SYSLOG-127.0.0.1}:514"`, `log "127.0.0.1:514"`},
+		{"Should keep empty variables with a dash default", `log "${EMPTY-127.0.0.1}:514"`, `log ":514"`},
+		{"Should use default of empty variables", `log "${EMPTY:-127.0.0.1}:514"`, `log "127.0.0.1:514"`},
+		{"Should expand lists", `"${LIST[*]}"`, `"a b"`},
+		{"Should keep escaped dollars", `"\${IP}"`, `"\${IP}"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExpandEnvironment(tt.input, lookup); got != tt.want {
+				t.Errorf("ExpandEnvironment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandEnvironmentUnquoted(t *testing.T) {
+	env := map[string]string{"IP": "10.0.0.1", "EMPTY": "", "LIST": "a b"}
+	lookup := func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"Should remove quotes of expanded variables", `server s1 "${IP}":80`, `server s1 10.0.0.1:80`},
+		{"Should keep quotes without variables", `http-request return "ok"`, `http-request return "ok"`},
+		{"Should keep quotes of values with spaces", `"${LIST[*]}"`, `"a b"`},
+		{"Should keep quotes of empty values", `"${EMPTY}"`, `""`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExpandEnvironmentUnquoted(tt.input, lookup); got != tt.want {
+				t.Errorf("ExpandEnvironmentUnquoted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -41,14 +41,27 @@ type Transaction struct {
 	// version
 	Version int64 `json:"_version,omitempty"`
 
+	// Time the transaction was started
+	// Format: date-time
+	// +kubebuilder:validation:Format=date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
 	// id
 	// Pattern: ^[^\s]+$
 	// +kubebuilder:validation:Pattern=`^[^\s]+$`
 	ID string `json:"id,omitempty"`
 
+	// Time the transaction was last changed
+	// Format: date-time
+	// +kubebuilder:validation:Format=date-time
+	LastModified strfmt.DateTime `json:"last_modified,omitempty"`
+
+	// User or client who started the transaction
+	Owner string `json:"owner,omitempty"`
+
 	// status
-	// Enum: ["failed","outdated","in_progress","success"]
-	// +kubebuilder:validation:Enum=failed;outdated;in_progress;success;
+	// Enum: ["failed","outdated","expired","in_progress","success"]
+	// +kubebuilder:validation:Enum=failed;outdated;expired;in_progress;success;
 	Status string `json:"status,omitempty"`
 }
 
@@ -56,10 +69,18 @@ type Transaction struct {
 func (m *Transaction) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateLastModified(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStatus(formats); err != nil {
 		res = append(res, err)
 	}
@@ -70,6 +91,18 @@ func (m *Transaction) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Transaction) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Transaction) validateID(formats strfmt.Registry) error {
 	if swag.IsZero(m.ID) { // not required
 		return nil
@@ -82,11 +115,23 @@ func (m *Transaction) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Transaction) validateLastModified(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastModified) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_modified", "body", "date-time", m.LastModified.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var transactionTypeStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["failed","outdated","in_progress","success"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["failed","outdated","expired","in_progress","success"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -102,6 +147,9 @@ const (
 	// TransactionStatusOutdated captures enum value "outdated"
 	TransactionStatusOutdated string = "outdated"
 
+	// TransactionStatusExpired captures enum value "expired"
+	TransactionStatusExpired string = "expired"
+
 	// TransactionStatusInProgress captures enum value "in_progress"
 	TransactionStatusInProgress string = "in_progress"
 
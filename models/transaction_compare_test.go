@@ -21,10 +21,13 @@ package models
 
 import (
 	"encoding/json"
+	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/go-faker/faker/v4"
 	"github.com/go-faker/faker/v4/pkg/options"
+	"github.com/go-openapi/strfmt"
 
 	jsoniter "github.com/json-iterator/go"
 )
@@ -87,6 +90,8 @@ func TestTransactionEqualFalse(t *testing.T) {
 			t.Error(err)
 		}
 		result.Version = sample.Version + 1
+		result.CreatedAt = strfmt.DateTime(time.Now().AddDate(rand.Intn(10), rand.Intn(12), rand.Intn(28)))
+		result.LastModified = strfmt.DateTime(time.Now().AddDate(rand.Intn(10), rand.Intn(12), rand.Intn(28)))
 		samples = append(samples, struct {
 			a, b Transaction
 		}{sample, result})
@@ -167,6 +172,8 @@ func TestTransactionDiffFalse(t *testing.T) {
 			t.Error(err)
 		}
 		result.Version = sample.Version + 1
+		result.CreatedAt = strfmt.DateTime(time.Now().AddDate(rand.Intn(10), rand.Intn(12), rand.Intn(28)))
+		result.LastModified = strfmt.DateTime(time.Now().AddDate(rand.Intn(10), rand.Intn(12), rand.Intn(28)))
 		samples = append(samples, struct {
 			a, b Transaction
 		}{sample, result})
@@ -175,7 +182,7 @@ func TestTransactionDiffFalse(t *testing.T) {
 	for _, sample := range samples {
 		result := sample.a.Diff(sample.b)
 		listDiffFields := GetListOfDiffFields(result)
-		if len(listDiffFields) != 3 {
+		if len(listDiffFields) != 6 {
 			json := jsoniter.ConfigCompatibleWithStandardLibrary
 			a, err := json.Marshal(&sample.a)
 			if err != nil {
@@ -185,7 +192,7 @@ func TestTransactionDiffFalse(t *testing.T) {
 			if err != nil {
 				t.Error(err)
 			}
-			t.Errorf("Expected Transaction to be different in 3 cases, but it is not (%d) %s %s", len(result), a, b)
+			t.Errorf("Expected Transaction to be different in 6 cases, but it is not (%d) %s %s", len(result), a, b)
 		}
 	}
 }
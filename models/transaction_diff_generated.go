@@ -18,6 +18,7 @@
 package models
 
 import (
+	"github.com/haproxytech/client-native/v6/models/funcs"
 	"github.com/haproxytech/go-method-gen/pkg/eqdiff"
 )
 
@@ -26,9 +27,24 @@ func (rec Transaction) Diff(obj Transaction, opts ...eqdiff.GoMethodGenOptions)
 	if rec.Version != obj.Version {
 		diff["Version"] = []interface{}{rec.Version, obj.Version}
 	}
+	for diffKey, diffValue := range funcs.DiffStrfmtDateTime(rec.CreatedAt, obj.CreatedAt, opts...) {
+		if diffKey != "" && diffKey[0] != '.' && diffKey[0] != '[' {
+			diffKey = "." + diffKey
+		}
+		diff["CreatedAt"+diffKey] = diffValue
+	}
 	if rec.ID != obj.ID {
 		diff["ID"] = []interface{}{rec.ID, obj.ID}
 	}
+	for diffKey, diffValue := range funcs.DiffStrfmtDateTime(rec.LastModified, obj.LastModified, opts...) {
+		if diffKey != "" && diffKey[0] != '.' && diffKey[0] != '[' {
+			diffKey = "." + diffKey
+		}
+		diff["LastModified"+diffKey] = diffValue
+	}
+	if rec.Owner != obj.Owner {
+		diff["Owner"] = []interface{}{rec.Owner, obj.Owner}
+	}
 	if rec.Status != obj.Status {
 		diff["Status"] = []interface{}{rec.Status, obj.Status}
 	}
@@ -23,6 +23,9 @@ import (
 
 func (rec Transaction) Equal(obj Transaction, opts ...eqdiff.GoMethodGenOptions) bool {
 	return rec.Version == obj.Version &&
+		rec.CreatedAt.Equal(obj.CreatedAt) &&
 		rec.ID == obj.ID &&
+		rec.LastModified.Equal(obj.LastModified) &&
+		rec.Owner == obj.Owner &&
 		rec.Status == obj.Status
 }
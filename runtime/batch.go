@@ -0,0 +1,193 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrBatchFailed can be matched with errors.Is on a *BatchError
+var ErrBatchFailed = errors.New("runtime batch failed")
+
+// SeverityNone is the severity of responses without a severity code
+const SeverityNone = -1
+
+const (
+	batchMarkerPrefix = "__client_native_batch_"
+	// room for the severity-output, master worker and quit commands added when sending
+	batchOverhead = 64
+)
+
+// BatchResult is the result of a command of a batch
+type BatchResult struct {
+	// Error is set when the command failed, or when the batch could not be sent
+	Error    error
+	Command  string
+	Response string
+	// Severity is the severity code HAProxy prefixed the response with, from 0 (emergency)
+	// to 7 (debug), or SeverityNone. Codes up to 3 (error) are failures.
+	Severity int
+}
+
+// BatchError reports the commands of a batch which failed
+type BatchError struct {
+	Results []BatchResult
+	// Failed are the indexes of the failed commands
+	Failed []int
+}
+
+func (e *BatchError) Error() string {
+	first := e.Results[e.Failed[0]]
+	return fmt.Sprintf("%d of %d runtime commands failed, first failure: %s", len(e.Failed), len(e.Results), first.Error.Error())
+}
+
+// Is reports whether target is ErrBatchFailed
+func (e *BatchError) Is(target error) bool {
+	return target == ErrBatchFailed
+}
+
+// ExecuteBatch executes commands with as few round trips as possible, by sending as many
+// commands separated by ';' as fit in HAProxy's buffer. A result is returned for each
+// command; when some of them failed, the error is a *BatchError.
+// Each response is delimited with the `echo` command, available since HAProxy 2.4.
+func (s *SingleRuntime) ExecuteBatch(commands []string) ([]BatchResult, error) {
+	if err := validateBatch(commands); err != nil {
+		return nil, err
+	}
+	results := make([]BatchResult, len(commands))
+	for start := 0; start < len(commands); {
+		end, line := s.batchLine(commands, start)
+		response, err := s.ExecuteRaw(line)
+		if err != nil {
+			for i := start; i < end; i++ {
+				results[i] = BatchResult{Command: commands[i], Severity: SeverityNone, Error: fmt.Errorf("%w [%s]", err, commands[i])}
+			}
+		} else {
+			parseBatchResponse(response, commands, start, end, results)
+		}
+		start = end
+	}
+	return results, batchError(results)
+}
+
+// executeSequentially executes commands one by one, for HAProxy versions without `echo`
+func (s *SingleRuntime) executeSequentially(commands []string) ([]BatchResult, error) {
+	if err := validateBatch(commands); err != nil {
+		return nil, err
+	}
+	results := make([]BatchResult, len(commands))
+	for i, cmd := range commands {
+		response, err := s.ExecuteRaw(cmd)
+		if err != nil {
+			results[i] = BatchResult{Command: cmd, Severity: SeverityNone, Error: fmt.Errorf("%w [%s]", err, cmd)}
+			continue
+		}
+		results[i] = batchResult(cmd, response)
+	}
+	return results, batchError(results)
+}
+
+func validateBatch(commands []string) error {
+	for _, cmd := range commands {
+		if strings.ContainsAny(cmd, ";\n") {
+			return fmt.Errorf("%w [%s]", ErrRuntimeInvalidChar, cmd)
+		}
+	}
+	return nil
+}
+
+// batchLine returns the command line for the commands from start, and the index
+// of the first command not included. At least one command is always included.
+func (s *SingleRuntime) batchLine(commands []string, start int) (int, string) {
+	separator := ";"
+	if s.masterWorkerMode {
+		// every command must be forwarded to the worker
+		separator = ";@1 "
+	}
+	var line strings.Builder
+	end := start
+	for end < len(commands) {
+		part := commands[end] + separator + "echo " + batchMarker(end)
+		if end > start {
+			part = separator + part
+		}
+		if end > start && line.Len()+len(part) > maxBufSize-batchOverhead {
+			break
+		}
+		line.WriteString(part)
+		end++
+	}
+	return end, line.String()
+}
+
+func batchMarker(i int) string {
+	return fmt.Sprintf("%s%d", batchMarkerPrefix, i)
+}
+
+// parseBatchResponse splits the response of the commands from start to end on the echoed markers
+func parseBatchResponse(response string, commands []string, start, end int, results []BatchResult) {
+	i := start
+	var current strings.Builder
+	for line := range strings.SplitSeq(response, "\n") {
+		if i < end && strings.TrimSpace(line) == batchMarker(i) {
+			results[i] = batchResult(commands[i], current.String())
+			current.Reset()
+			i++
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+	}
+	for ; i < end; i++ {
+		results[i] = BatchResult{
+			Command:  commands[i],
+			Severity: SeverityNone,
+			Error:    fmt.Errorf("no response received [%s]", commands[i]),
+		}
+	}
+}
+
+func batchResult(command, response string) BatchResult {
+	result := BatchResult{
+		Command:  command,
+		Response: strings.TrimSpace(response),
+		Severity: SeverityNone,
+	}
+	if len(result.Response) >= 4 && result.Response[0] == '[' && result.Response[2] == ']' && result.Response[3] == ':' &&
+		result.Response[1] >= '0' && result.Response[1] <= '7' {
+		result.Severity = int(result.Response[1] - '0')
+		result.Response = strings.TrimSpace(result.Response[4:])
+		if result.Severity <= 3 {
+			result.Error = fmt.Errorf("[%d] %s [%s]", result.Severity, result.Response, command)
+		}
+	}
+	return result
+}
+
+func batchError(results []BatchResult) error {
+	batchErr := &BatchError{Results: results}
+	for i, r := range results {
+		if r.Error != nil {
+			batchErr.Failed = append(batchErr.Failed, i)
+		}
+	}
+	if len(batchErr.Failed) == 0 {
+		return nil
+	}
+	return batchErr
+}
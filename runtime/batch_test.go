@@ -0,0 +1,86 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/runtime/options"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSingleRuntime_batchLine(t *testing.T) {
+	commands := make([]string, 500)
+	for i := range commands {
+		commands[i] = fmt.Sprintf("set server be_app/srv%d weight 10", i)
+	}
+	s := &SingleRuntime{}
+	sent := 0
+	for start := 0; start < len(commands); {
+		end, line := s.batchLine(commands, start)
+		require.Greater(t, end, start)
+		require.LessOrEqual(t, len(line), maxBufSize-batchOverhead)
+		require.Equal(t, end-start, strings.Count(line, "echo "+batchMarkerPrefix))
+		sent += end - start
+		start = end
+	}
+	require.Equal(t, len(commands), sent)
+
+	s.masterWorkerMode = true
+	_, line := s.batchLine(commands[:2], 0)
+	require.Equal(t, "set server be_app/srv0 weight 10;@1 echo __client_native_batch_0;@1 set server be_app/srv1 weight 10;@1 echo __client_native_batch_1", line)
+}
+
+func TestClient_ExecuteBatch(t *testing.T) {
+	haProxy := NewHAProxyMock(t)
+	haProxy.Start()
+	defer haProxy.Stop()
+	// the mock answers on the last command of a line, the echo of the last marker
+	haProxy.SetResponses(&map[string]string{
+		"show info\n": "Name: HAProxy\nVersion: 2.8.5\n",
+		"echo __client_native_batch_2\n": "\n__client_native_batch_0\n\n[3]: No such server.\n\n__client_native_batch_1\n\n" +
+			"[6]: Health check updated.\n\n__client_native_batch_2\n",
+	})
+	rt, err := New(context.Background(), options.Socket(haProxy.Addr().String()))
+	require.NoError(t, err)
+
+	commands := []string{
+		"set server be_app/srv1 weight 10",
+		"set server be_app/missing weight 10",
+		"set server be_app/srv1 health up",
+	}
+	results, err := rt.ExecuteBatch(commands)
+	require.ErrorIs(t, err, ErrBatchFailed)
+	batchErr := &BatchError{}
+	require.ErrorAs(t, err, &batchErr)
+	require.Equal(t, []int{1}, batchErr.Failed)
+	require.Len(t, results, 3)
+
+	require.NoError(t, results[0].Error)
+	require.Equal(t, SeverityNone, results[0].Severity)
+	require.Equal(t, 3, results[1].Severity)
+	require.Equal(t, "No such server.", results[1].Response)
+	require.Error(t, results[1].Error)
+	require.NoError(t, results[2].Error)
+	require.Equal(t, 6, results[2].Severity)
+	require.Equal(t, "Health check updated.", results[2].Response)
+
+	_, err = rt.ExecuteBatch([]string{"show info;show stat"})
+	require.ErrorIs(t, err, ErrRuntimeInvalidChar)
+}
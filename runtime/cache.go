@@ -0,0 +1,124 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package runtime
+
+import (
+	"fmt"
+	"strings"
+
+	native_errors "github.com/haproxytech/client-native/v6/errors"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+// ShowCaches returns the caches with their entries
+func (s *SingleRuntime) ShowCaches() (models.RuntimeCaches, error) {
+	response, err := s.ExecuteWithResponse("show cache")
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", err.Error(), native_errors.ErrNotFound)
+	}
+	return parseCaches(response)
+}
+
+// parseCaches parses the output of `show cache`:
+//
+//	0x7f6ac7f9d038: my_cache (shctx:0x7f6ac7f9d000, available blocks:1023)
+//	0x7f6ac7fa8c14 hash:1295393005 vary:0x0000000000000000 size:186 (1 blocks), refcount:0, expire:56
+func parseCaches(response string) (models.RuntimeCaches, error) {
+	if strings.HasPrefix(strings.TrimSpace(response), "Unknown command") {
+		return nil, fmt.Errorf("%s %w", strings.TrimSpace(response), native_errors.ErrGeneral)
+	}
+	caches := models.RuntimeCaches{}
+	var cache *models.RuntimeCache
+	for line := range strings.SplitSeq(response, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		address, rest, found := strings.Cut(line, ": ")
+		if found && !strings.Contains(address, " ") {
+			c, err := parseCacheHeader(address, rest)
+			if err != nil {
+				return nil, err
+			}
+			cache = c
+			caches = append(caches, cache)
+			continue
+		}
+		if cache == nil {
+			continue
+		}
+		entry := parseCacheEntry(line)
+		cache.Entries = append(cache.Entries, entry)
+		if entry.Blocks != nil {
+			*cache.UsedBlocks += *entry.Blocks
+		}
+		if entry.Size != nil {
+			*cache.TotalSize += *entry.Size
+		}
+	}
+	return caches, nil
+}
+
+// parseCacheHeader parses "my_cache (shctx:0x7f6ac7f9d000, available blocks:1023)"
+func parseCacheHeader(address, rest string) (*models.RuntimeCache, error) {
+	name, attrs, found := strings.Cut(rest, " (")
+	if !found {
+		return nil, fmt.Errorf("failed to parse cache line '%s: %s' %w", address, rest, native_errors.ErrGeneral)
+	}
+	var used, size int64
+	cache := &models.RuntimeCache{
+		Name:       name,
+		Address:    address,
+		UsedBlocks: &used,
+		TotalSize:  &size,
+	}
+	for attr := range strings.SplitSeq(strings.TrimSuffix(attrs, ")"), ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(attr), ":")
+		if key == "available blocks" {
+			cache.AvailableBlocks = parseInt64P(value)
+		}
+	}
+	return cache, nil
+}
+
+// parseCacheEntry parses "0x7f6ac7fa8c14 hash:1295393005 vary:0x0 size:186 (1 blocks), refcount:0, expire:56"
+func parseCacheEntry(line string) *models.RuntimeCacheEntry {
+	fields := strings.Fields(strings.NewReplacer(",", " ", "(", " ", ")", " ").Replace(line))
+	entry := &models.RuntimeCacheEntry{Address: fields[0]}
+	for i, field := range fields[1:] {
+		if field == "blocks" && i > 0 {
+			entry.Blocks = parseInt64P(fields[i])
+			continue
+		}
+		key, value, found := strings.Cut(field, ":")
+		if !found {
+			continue
+		}
+		switch key {
+		case "hash":
+			entry.Hash = value
+		case "vary":
+			entry.Vary = value
+		case "size":
+			entry.Size = parseInt64P(value)
+		case "refcount":
+			entry.Refcount = parseInt64P(value)
+		case "expire":
+			entry.Expire = parseInt64P(value)
+		}
+	}
+	return entry
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package runtime
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/misc"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+func TestParseCaches(t *testing.T) {
+	response := `0x7f6ac7f9d038: static_cache (shctx:0x7f6ac7f9d000, available blocks:1021)
+0x7f6ac7fa8c14 hash:1295393005 vary:0x0000000000000000 size:186 (1 blocks), refcount:0, expire:56
+0x7f6ac7fa8e54 hash:3486271001 vary:0x0011223344556677 size:1500 (2 blocks), refcount:1, expire:3
+0x7f6ac7f9e038: api_cache (shctx:0x7f6ac7f9e000, available blocks:512)
+`
+	want := models.RuntimeCaches{
+		&models.RuntimeCache{
+			Name: "static_cache", Address: "0x7f6ac7f9d038", AvailableBlocks: misc.Int64P(1021),
+			UsedBlocks: misc.Int64P(3), TotalSize: misc.Int64P(1686),
+			Entries: []*models.RuntimeCacheEntry{
+				{
+					Address: "0x7f6ac7fa8c14", Hash: "1295393005", Vary: "0x0000000000000000",
+					Size: misc.Int64P(186), Blocks: misc.Int64P(1), Refcount: misc.Int64P(0), Expire: misc.Int64P(56),
+				},
+				{
+					Address: "0x7f6ac7fa8e54", Hash: "3486271001", Vary: "0x0011223344556677",
+					Size: misc.Int64P(1500), Blocks: misc.Int64P(2), Refcount: misc.Int64P(1), Expire: misc.Int64P(3),
+				},
+			},
+		},
+		&models.RuntimeCache{
+			Name: "api_cache", Address: "0x7f6ac7f9e038", AvailableBlocks: misc.Int64P(512),
+			UsedBlocks: misc.Int64P(0), TotalSize: misc.Int64P(0),
+		},
+	}
+	got, err := parseCaches(response)
+	if err != nil {
+		t.Fatalf("parseCaches() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseCaches() = %+v, want %+v", got, want)
+	}
+}
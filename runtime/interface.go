@@ -20,6 +20,8 @@ import (
 	"io"
 	"mime/multipart"
 
+	"github.com/haproxytech/client-native/v6/capabilities"
+	"github.com/haproxytech/client-native/v6/diagnostics"
 	"github.com/haproxytech/client-native/v6/models"
 	"github.com/haproxytech/client-native/v6/runtime/options"
 )
@@ -93,6 +95,10 @@ type Servers interface {
 	GetServerState(backend, server string) (*models.RuntimeServer, error)
 	// SetServerCheckPort set health heck port for server
 	SetServerCheckPort(backend, server string, port int) error
+	// GetServersConnections returns the connection usage and idle pools of the servers, of all backends when backend is empty
+	GetServersConnections(backend string) (models.ServerConnPools, error)
+	// DumpServersState returns the servers state in the format of a server-state-file, of all backends when backend is empty
+	DumpServersState(backend string) (string, error)
 }
 
 type ACLs interface {
@@ -128,11 +134,19 @@ type Info interface {
 	GetInfo() (models.ProcessInfo, error)
 	// GetVersion() returns running HAProxy version
 	GetVersion() (HAProxyVersion, error)
+	// CheckCapability returns an error if the running HAProxy doesn't support a command, keyword or option
+	CheckCapability(kind capabilities.Kind, name string) error
 }
 
 type Manage interface {
 	// Reloads HAProxy's configuration file. Similar to SIGUSR2. Returns the startup logs.
 	Reload() (string, error)
+	// ReloadWithDiagnostics reloads HAProxy like Reload, and returns the parsed startup logs
+	ReloadWithDiagnostics() (diagnostics.Diagnostics, error)
+	// GetStartupLogs returns the startup logs of the current HAProxy worker from the master CLI
+	GetStartupLogs() (string, error)
+	// GetStartupDiagnostics returns the parsed startup logs of the current HAProxy worker
+	GetStartupDiagnostics() (diagnostics.Diagnostics, error)
 	// Clears max counters
 	ClearCounters() error
 	// Clears counters
@@ -142,6 +156,10 @@ type Manage interface {
 type Raw interface {
 	// ExecuteRaw does not process response, just returns its value
 	ExecuteRaw(command string) (string, error)
+	// ExecuteBatch executes commands with as few round trips as possible and returns a result for each one
+	ExecuteBatch(commands []string) ([]BatchResult, error)
+	// Use adds middlewares wrapping all the commands sent to the runtime API
+	Use(middlewares ...Middleware)
 }
 
 type SSL interface {
@@ -183,6 +201,10 @@ type SSL interface {
 	UpdateOcspResponse(name string) (*models.SslOcspResponse, error)
 	ShowSSLProviders() (*models.SslProviders, error)
 	SetRateLimitSSLSessionGlobal(value uint64) error
+	ShowTLSKeys() (models.TLSTicketKeysFiles, error)
+	ShowTLSKeysEntries(id string) (models.TLSTicketKeys, error)
+	SetTLSKey(id, key string) error
+	RotateTLSKeys() error
 }
 
 type Acme interface {
@@ -190,6 +212,18 @@ type Acme interface {
 	AcmeStatus() (models.AcmeStatus, error)
 }
 
+type Quic interface {
+	// ShowQuic returns the QUIC connections with their streams, including closing ones when all is true
+	ShowQuic(all bool) (models.QuicConnections, error)
+}
+
+type Caches interface {
+	// ShowCaches returns the caches with their usage and entries
+	ShowCaches() (models.RuntimeCaches, error)
+	// GetCache returns a cache with its usage and entries
+	GetCache(name string) (*models.RuntimeCache, error)
+}
+
 type Runtime interface {
 	Info
 	Frontend
@@ -201,6 +235,8 @@ type Runtime interface {
 	Raw
 	SSL
 	Acme
+	Quic
+	Caches
 	SocketPath() string
 	IsStatsSocket() bool
 }
@@ -0,0 +1,225 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ErrCommandNotAllowed is returned for commands rejected by the Allowlist middleware
+var ErrCommandNotAllowed = errors.New("runtime command not allowed")
+
+const redactedPayload = "<redacted>"
+
+var (
+	privateKeyPattern = regexp.MustCompile(`(?s)-----BEGIN [A-Z ]*PRIVATE KEY-----.*?-----END [A-Z ]*PRIVATE KEY-----`)
+	// TLS ticket keys listed by `show tls-keys <id>`, as "<file id>.<slot> <key>"
+	tlsKeyPattern = regexp.MustCompile(`(?m)^(\s*\d+\.\d+\s+)\S+`)
+)
+
+// Command is a command sent to the runtime API
+type Command struct {
+	// Command is the command line, followed by its payload if any
+	Command string
+	// SocketPath is the path of the runtime API socket
+	SocketPath string
+	// Master is true when the command is sent to the master socket
+	Master bool
+}
+
+// Names returns the commands of a command line, as several commands can be separated by ';'
+func (c Command) Names() []string {
+	line, _, _ := strings.Cut(c.Command, "\n")
+	var names []string
+	for name := range strings.SplitSeq(line, ";") {
+		name = strings.TrimSpace(name)
+		// master socket prefix for a worker: "@1 show info" or "@!1234 show info"
+		if strings.HasPrefix(name, "@") {
+			_, name, _ = strings.Cut(name, " ")
+			name = strings.TrimSpace(name)
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Redacted returns the command with its secrets replaced, so it can be logged.
+// Payloads of commands setting certificates, CA files, CRL files and OCSP responses
+// are removed, and so are TLS ticket keys.
+func (c Command) Redacted() string {
+	line, payload, hasPayload := strings.Cut(c.Command, "\n")
+	for _, name := range c.Names() {
+		if strings.HasPrefix(name, "set ssl tls-key ") {
+			fields := strings.Fields(name)
+			if len(fields) > 4 {
+				line = strings.Replace(line, fields[4], redactedPayload, 1)
+			}
+		}
+	}
+	if !hasPayload || strings.TrimSpace(payload) == "" {
+		return line
+	}
+	for _, name := range c.Names() {
+		for _, prefix := range []string{"set ssl cert ", "set ssl ca-file ", "add ssl ca-file ", "set ssl crl-file ", "set ssl ocsp-response "} {
+			if strings.HasPrefix(name, prefix) {
+				return line + "\n" + redactedPayload
+			}
+		}
+	}
+	return c.Command
+}
+
+// RedactedResponse returns a response of the command with its secrets replaced, so it can be
+// logged or recorded. Private keys, as dumped with certificates, are removed from every response,
+// and so are the TLS ticket keys listed by `show tls-keys`.
+func (c Command) RedactedResponse(response string) string {
+	response = privateKeyPattern.ReplaceAllString(response, redactedPayload)
+	for _, name := range c.Names() {
+		if strings.HasPrefix(name, "show tls-keys") {
+			return tlsKeyPattern.ReplaceAllString(response, "${1}"+redactedPayload)
+		}
+	}
+	return response
+}
+
+// IsMutating returns true unless all commands of the command line only read data.
+// Unknown commands are considered as mutating.
+func (c Command) IsMutating() bool {
+	for _, name := range c.Names() {
+		readOnly := false
+		for _, prefix := range []string{"show ", "get ", "dump ", "help", "echo ", "prompt", "quit", "set severity-output "} {
+			if name == strings.TrimSpace(prefix) || strings.HasPrefix(name, prefix) {
+				readOnly = true
+				break
+			}
+		}
+		if !readOnly {
+			return true
+		}
+	}
+	return false
+}
+
+// CommandHandler executes a runtime API command and returns its raw response
+type CommandHandler func(cmd Command) (string, error)
+
+// Middleware wraps the execution of runtime API commands. It can inspect or change
+// the command and the response, or not call next at all to stop the command.
+type Middleware func(next CommandHandler) CommandHandler
+
+// CommandEvent is the outcome of a runtime API command, with its payload redacted
+type CommandEvent struct {
+	Error      error
+	Command    string
+	SocketPath string
+	Duration   time.Duration
+	// ResponseSize is the length of the raw response
+	ResponseSize int
+	Master       bool
+}
+
+// Observe returns a middleware calling fn after each command, for auditing or metrics
+func Observe(fn func(event CommandEvent)) Middleware {
+	return func(next CommandHandler) CommandHandler {
+		return func(cmd Command) (string, error) {
+			start := time.Now()
+			response, err := next(cmd)
+			fn(CommandEvent{
+				Command:      cmd.Redacted(),
+				SocketPath:   cmd.SocketPath,
+				Master:       cmd.Master,
+				Duration:     time.Since(start),
+				ResponseSize: len(response),
+				Error:        err,
+			})
+			return response, err
+		}
+	}
+}
+
+// LogCommands returns a middleware logging each command with its latency and outcome.
+// Commands are logged at debug level, failed ones at warning level.
+func LogCommands(logger *slog.Logger) Middleware {
+	return Observe(func(event CommandEvent) {
+		level := slog.LevelDebug
+		attrs := []slog.Attr{
+			slog.String("command", event.Command),
+			slog.String("socket", event.SocketPath),
+			slog.Duration("duration", event.Duration),
+			slog.Int("response_size", event.ResponseSize),
+		}
+		if event.Error != nil {
+			level = slog.LevelWarn
+			attrs = append(attrs, slog.String("error", event.Error.Error()))
+		}
+		logger.LogAttrs(context.Background(), level, "runtime command", attrs...)
+	})
+}
+
+// Allowlist returns a middleware rejecting commands not starting with one of the prefixes,
+// for example "show " or "set server ". Every command of a command line must be allowed.
+func Allowlist(prefixes ...string) Middleware {
+	return func(next CommandHandler) CommandHandler {
+		return func(cmd Command) (string, error) {
+			for _, name := range cmd.Names() {
+				allowed := false
+				for _, prefix := range prefixes {
+					if strings.HasPrefix(name, prefix) {
+						allowed = true
+						break
+					}
+				}
+				if !allowed {
+					return "", fmt.Errorf("%s: %w", name, ErrCommandNotAllowed)
+				}
+			}
+			return next(cmd)
+		}
+	}
+}
+
+// DryRun returns a middleware which doesn't send mutating commands to HAProxy, but passes
+// them to record and returns an empty response as HAProxy does for most successful changes.
+// Commands that only read data are still sent.
+func DryRun(record func(cmd Command)) Middleware {
+	return func(next CommandHandler) CommandHandler {
+		return func(cmd Command) (string, error) {
+			if !cmd.IsMutating() {
+				return next(cmd)
+			}
+			if record != nil {
+				record(cmd)
+			}
+			return "", nil
+		}
+	}
+}
+
+// chain wraps handler with the middlewares, the first one being the outermost
+func chain(handler CommandHandler, middlewares []Middleware) CommandHandler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
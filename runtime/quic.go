@@ -0,0 +1,211 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package runtime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	native_errors "github.com/haproxytech/client-native/v6/errors"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+// ShowQuic returns the QUIC connections of all frontends with their streams.
+// When all is true, connections being closed are also returned.
+func (s *SingleRuntime) ShowQuic(all bool) (models.QuicConnections, error) {
+	cmd := "show quic full"
+	if all {
+		cmd += " all"
+	}
+	response, err := s.ExecuteWithResponse(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", err.Error(), native_errors.ErrNotFound)
+	}
+	return parseQuic(response)
+}
+
+// parseQuic parses the output of `show quic`, either in full format:
+//
+//	# show quic full
+//	* 0x7f2b3c0a7a00[00]: scid=d6bc31ce0a6e2f4a dcid=0d56a39d1c3b2a19
+//	  loc. TPs: odcid=... iscid=...
+//	  st=opened mux=ready expire=29s
+//	  fd=-1 local_addr=127.0.0.1:443 foreign_addr=127.0.0.1:41726
+//	  [01rtt]             rx.ackrng=1          tx.inflight=0
+//	  srtt=2  rttvar=1  rttmin=1  ptocnt=0  cwnd=14100  mcwnd=14100  sentpkts=10  lostpkts=0 reorderedpkts=0
+//	  qcs=0x7f2b3c0c1000 id=0 flags=0x0 st=OPN rxoff=39 txoff=1024
+//
+// or in oneline format:
+//
+//	# conn/frontend                     state   in_flight infl_p lost_p         Local Address           Foreign Address      local & remote CIDs
+//	0x7f2b3c0a7a00[00]/https            ESTAB           0      0      0         127.0.0.1:443         127.0.0.1:41726      d6bc31ce 0d56a39d
+func parseQuic(response string) (models.QuicConnections, error) {
+	if strings.HasPrefix(strings.TrimSpace(response), "Unknown command") {
+		return nil, fmt.Errorf("%s %w", strings.TrimSpace(response), native_errors.ErrGeneral)
+	}
+
+	connections := models.QuicConnections{}
+	var conn *models.QuicConnection
+	for line := range strings.SplitSeq(response, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "" || line[0] == '#':
+			continue
+		case strings.HasPrefix(line, "* "):
+			header, rest, _ := strings.Cut(line[2:], ": ")
+			conn = &models.QuicConnection{}
+			conn.ID, conn.Thread = parseQuicConnectionID(strings.TrimSuffix(header, ":"))
+			for key, value := range quicFields(rest) {
+				switch key {
+				case "scid":
+					conn.LocalCid = value
+				case "dcid":
+					conn.RemoteCid = value
+				}
+			}
+			connections = append(connections, conn)
+		case strings.HasPrefix(line, "0x"):
+			c, err := parseQuicOneline(line)
+			if err != nil {
+				return nil, err
+			}
+			conn = nil
+			connections = append(connections, c)
+		case conn == nil:
+			continue
+		case strings.HasPrefix(line, "qcs="):
+			conn.Streams = append(conn.Streams, parseQuicStream(line))
+		default:
+			for key, value := range quicFields(line) {
+				setQuicConnectionField(conn, key, value)
+			}
+		}
+	}
+	return connections, nil
+}
+
+// parseQuicConnectionID splits "0x7f2b3c0a7a00[00]" into the connection address and its thread
+func parseQuicConnectionID(s string) (string, *int64) {
+	id, thread, found := strings.Cut(s, "[")
+	if !found {
+		return s, nil
+	}
+	return id, parseQuicInt(strings.TrimSuffix(thread, "]"))
+}
+
+func parseQuicOneline(line string) (*models.QuicConnection, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 7 {
+		return nil, fmt.Errorf("failed to parse quic connection line '%s' %w", line, native_errors.ErrGeneral)
+	}
+	conn := &models.QuicConnection{
+		State:           fields[1],
+		InFlight:        parseQuicInt(fields[2]),
+		InFlightPackets: parseQuicInt(fields[3]),
+		LostPackets:     parseQuicInt(fields[4]),
+		LocalAddress:    fields[5],
+		ForeignAddress:  fields[6],
+	}
+	id, frontend, _ := strings.Cut(fields[0], "/")
+	conn.ID, conn.Thread = parseQuicConnectionID(id)
+	conn.Frontend = frontend
+	if len(fields) > 7 {
+		conn.LocalCid = fields[7]
+	}
+	if len(fields) > 8 {
+		conn.RemoteCid = fields[8]
+	}
+	return conn, nil
+}
+
+func parseQuicStream(line string) *models.QuicStream {
+	stream := &models.QuicStream{}
+	for key, value := range quicFields(line) {
+		switch key {
+		case "qcs":
+			stream.Address = value
+		case "id":
+			stream.ID = parseQuicInt(value)
+		case "flags":
+			stream.Flags = value
+		case "st":
+			stream.State = value
+		case "rxoff":
+			stream.RxOffset = parseQuicInt(value)
+		case "txoff":
+			stream.TxOffset = parseQuicInt(value)
+		}
+	}
+	return stream
+}
+
+func setQuicConnectionField(conn *models.QuicConnection, key, value string) {
+	switch key {
+	case "st":
+		conn.State = value
+	case "mux":
+		conn.MuxState = value
+	case "expire":
+		conn.Expire = value
+	case "local_addr":
+		conn.LocalAddress = value
+	case "foreign_addr":
+		conn.ForeignAddress = value
+	case "srtt":
+		conn.Srtt = parseQuicInt(value)
+	case "rttvar":
+		conn.Rttvar = parseQuicInt(value)
+	case "rttmin":
+		conn.Rttmin = parseQuicInt(value)
+	case "ptocnt":
+		conn.PtoCount = parseQuicInt(value)
+	case "cwnd":
+		conn.Cwnd = parseQuicInt(value)
+	case "mcwnd":
+		conn.MaxCwnd = parseQuicInt(value)
+	case "sentpkts":
+		conn.SentPackets = parseQuicInt(value)
+	case "lostpkts":
+		conn.LostPackets = parseQuicInt(value)
+	case "reorderedpkts":
+		conn.ReorderedPackets = parseQuicInt(value)
+	}
+}
+
+// quicFields returns the key=value pairs of a line
+func quicFields(line string) map[string]string {
+	fields := map[string]string{}
+	for _, field := range strings.Fields(line) {
+		if key, value, found := strings.Cut(field, "="); found {
+			fields[key] = value
+		}
+	}
+	return fields
+}
+
+// parseQuicInt parses the leading number of values such as "29" or "29ms"
+func parseQuicInt(s string) *int64 {
+	end := 0
+	for end < len(s) && (s[end] >= '0' && s[end] <= '9' || end == 0 && s[end] == '-') {
+		end++
+	}
+	v, err := strconv.ParseInt(s[:end], 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package runtime
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/misc"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+func TestParseQuic(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     models.QuicConnections
+	}{
+		{
+			name: "full format",
+			response: `* 0x7f2b3c0a7a00[01]: scid=d6bc31ce0a6e2f4a dcid=0d56a39d1c3b2a19
+  loc. TPs: odcid=8c2b5a6f iscid=d6bc31ce0a6e2f4a
+            midle_timeout=30000ms mudp_payload_sz=2048 ack_delay_exp=3 mack_delay=25ms act_cid_limit=8
+  st=opened mux=ready expire=29s
+  fd=-1 local_addr=127.0.0.1:443 foreign_addr=127.0.0.1:41726
+  [initl]             rx.ackrng=1          tx.inflight=0
+  [01rtt]             rx.ackrng=1          tx.inflight=0
+  srtt=2  rttvar=1  rttmin=1  ptocnt=0  cwnd=14100  mcwnd=15200  sentpkts=10  lostpkts=2 reorderedpkts=1
+  qcs=0x7f2b3c0c1000 id=0 flags=0x0 st=OPN rxoff=39 txoff=1024
+  qcs=0x7f2b3c0c1200 id=4 flags=0x1 st=HCR rxoff=0 txoff=0
+`,
+			want: models.QuicConnections{
+				&models.QuicConnection{
+					ID:               "0x7f2b3c0a7a00",
+					Thread:           misc.Int64P(1),
+					LocalCid:         "d6bc31ce0a6e2f4a",
+					RemoteCid:        "0d56a39d1c3b2a19",
+					State:            "opened",
+					MuxState:         "ready",
+					Expire:           "29s",
+					LocalAddress:     "127.0.0.1:443",
+					ForeignAddress:   "127.0.0.1:41726",
+					Srtt:             misc.Int64P(2),
+					Rttvar:           misc.Int64P(1),
+					Rttmin:           misc.Int64P(1),
+					PtoCount:         misc.Int64P(0),
+					Cwnd:             misc.Int64P(14100),
+					MaxCwnd:          misc.Int64P(15200),
+					SentPackets:      misc.Int64P(10),
+					LostPackets:      misc.Int64P(2),
+					ReorderedPackets: misc.Int64P(1),
+					Streams: []*models.QuicStream{
+						{Address: "0x7f2b3c0c1000", ID: misc.Int64P(0), Flags: "0x0", State: "OPN", RxOffset: misc.Int64P(39), TxOffset: misc.Int64P(1024)},
+						{Address: "0x7f2b3c0c1200", ID: misc.Int64P(4), Flags: "0x1", State: "HCR", RxOffset: misc.Int64P(0), TxOffset: misc.Int64P(0)},
+					},
+				},
+			},
+		},
+		{
+			name: "oneline format",
+			response: `# conn/frontend                     state   in_flight infl_p lost_p         Local Address           Foreign Address      local & remote CIDs
+0x7f2b3c0a7a00[00]/https            ESTAB           0      0      0         127.0.0.1:443         127.0.0.1:41726      d6bc31ce 0d56a39d
+`,
+			want: models.QuicConnections{
+				&models.QuicConnection{
+					ID:              "0x7f2b3c0a7a00",
+					Thread:          misc.Int64P(0),
+					Frontend:        "https",
+					State:           "ESTAB",
+					InFlight:        misc.Int64P(0),
+					InFlightPackets: misc.Int64P(0),
+					LostPackets:     misc.Int64P(0),
+					LocalAddress:    "127.0.0.1:443",
+					ForeignAddress:  "127.0.0.1:41726",
+					LocalCid:        "d6bc31ce",
+					RemoteCid:       "0d56a39d",
+				},
+			},
+		},
+		{
+			name:     "no connections",
+			response: "# conn/frontend state in_flight infl_p lost_p Local Address Foreign Address local & remote CIDs\n",
+			want:     models.QuicConnections{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseQuic(tt.response)
+			if err != nil {
+				t.Fatalf("parseQuic() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseQuic() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
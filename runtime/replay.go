@@ -0,0 +1,198 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/google/renameio"
+	"github.com/haproxytech/client-native/v6/runtime/options"
+)
+
+// ErrReplayNotFound is returned when replaying a command which was not recorded
+var ErrReplayNotFound = errors.New("command not found in replay fixture")
+
+// replaySocketPath is the socket path reported by replaying runtime clients
+const replaySocketPath = "replay"
+
+// Fixture is a recorded runtime API session
+type Fixture struct {
+	// HAProxyVersion is the version of the recorded HAProxy, if known
+	HAProxyVersion string `json:"haproxy_version,omitempty"`
+	// Exchanges are the commands in the order they were sent
+	Exchanges []FixtureExchange `json:"exchanges"`
+}
+
+// FixtureExchange is a recorded command with its response. Secrets are redacted
+// from commands, which are matched on their redacted form when replayed, and from responses.
+type FixtureExchange struct {
+	Command  string `json:"command"`
+	Response string `json:"response"`
+	Error    string `json:"error,omitempty"`
+	Master   bool   `json:"master,omitempty"`
+}
+
+// LoadFixture reads a fixture file written by Recorder.Save
+func LoadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fixture := &Fixture{}
+	if err = json.Unmarshal(data, fixture); err != nil {
+		return nil, fmt.Errorf("invalid fixture %s: %w", path, err)
+	}
+	return fixture, nil
+}
+
+// Save writes the fixture to a file
+func (f *Fixture) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return renameio.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Recorder captures the commands sent to the runtime API and their responses
+type Recorder struct {
+	fixture Fixture
+	mu      sync.Mutex
+}
+
+// NewRecorder returns a recorder, see Attach and Middleware
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Attach starts recording the commands sent by a runtime client, along with its HAProxy version
+func (r *Recorder) Attach(rt Runtime) {
+	if version, err := rt.GetVersion(); err == nil {
+		r.mu.Lock()
+		r.fixture.HAProxyVersion = version.String()
+		r.mu.Unlock()
+	}
+	rt.Use(r.Middleware())
+}
+
+// Middleware returns the middleware recording the commands
+func (r *Recorder) Middleware() Middleware {
+	return func(next CommandHandler) CommandHandler {
+		return func(cmd Command) (string, error) {
+			response, err := next(cmd)
+			exchange := FixtureExchange{
+				Command:  cmd.Redacted(),
+				Response: cmd.RedactedResponse(response),
+				Master:   cmd.Master,
+			}
+			if err != nil {
+				exchange.Error = err.Error()
+			}
+			r.mu.Lock()
+			r.fixture.Exchanges = append(r.fixture.Exchanges, exchange)
+			if r.fixture.HAProxyVersion == "" && cmd.Command == "show info" && err == nil {
+				if version, vErr := parseShowInfoVersion(response); vErr == nil {
+					r.fixture.HAProxyVersion = version.String()
+				}
+			}
+			r.mu.Unlock()
+			return response, err
+		}
+	}
+}
+
+// Fixture returns a copy of the recorded session
+func (r *Recorder) Fixture() *Fixture {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fixture := &Fixture{HAProxyVersion: r.fixture.HAProxyVersion}
+	fixture.Exchanges = append(fixture.Exchanges, r.fixture.Exchanges...)
+	return fixture
+}
+
+// Save writes the recorded session to a fixture file
+func (r *Recorder) Save(path string) error {
+	return r.Fixture().Save(path)
+}
+
+// Replay returns a middleware answering commands from the fixture instead of HAProxy.
+// When a command was recorded several times, its responses are served in order and
+// the last one is repeated.
+func Replay(fixture *Fixture) Middleware {
+	type key struct {
+		command string
+		master  bool
+	}
+	exchanges := map[key][]FixtureExchange{}
+	for _, e := range fixture.Exchanges {
+		k := key{command: e.Command, master: e.Master}
+		exchanges[k] = append(exchanges[k], e)
+	}
+	served := map[key]int{}
+	var mu sync.Mutex
+
+	return func(_ CommandHandler) CommandHandler {
+		return func(cmd Command) (string, error) {
+			k := key{command: cmd.Redacted(), master: cmd.Master}
+			mu.Lock()
+			defer mu.Unlock()
+			recorded, ok := exchanges[k]
+			if !ok {
+				return "", fmt.Errorf("%s: %w", k.command, ErrReplayNotFound)
+			}
+			e := recorded[min(served[k], len(recorded)-1)]
+			served[k]++
+			if e.Error != "" {
+				return e.Response, errors.New(e.Error)
+			}
+			return e.Response, nil
+		}
+	}
+}
+
+// NewReplay returns a runtime client serving the responses of a recorded session,
+// without connecting to HAProxy. Options such as MasterSocket and MapsDir are used
+// as for New, while socket paths are ignored.
+func NewReplay(_ context.Context, fixture *Fixture, opt ...options.RuntimeOption) (Runtime, error) {
+	c := &client{
+		options: options.RuntimeOptions{},
+	}
+	for _, option := range opt {
+		if err := option.Set(&c.options); err != nil {
+			return nil, err
+		}
+	}
+	c.runtime = &SingleRuntime{
+		socketPath:       replaySocketPath,
+		masterWorkerMode: c.options.MasterSocketData != nil,
+	}
+	c.runtime.Use(Replay(fixture))
+	if fixture.HAProxyVersion != "" {
+		version := &HAProxyVersion{}
+		if err := version.ParseHAProxyVersion(fixture.HAProxyVersion); err != nil {
+			return nil, fmt.Errorf("invalid fixture version %s: %w", fixture.HAProxyVersion, err)
+		}
+		c.version = version
+	} else {
+		_, _ = c.GetVersion()
+	}
+	return c, nil
+}
@@ -16,15 +16,19 @@
 package runtime
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 
-	"github.com/Masterminds/semver/v3"
+	"github.com/haproxytech/client-native/v6/capabilities"
+	"github.com/haproxytech/client-native/v6/diagnostics"
 	native_errors "github.com/haproxytech/client-native/v6/errors"
 	"github.com/haproxytech/client-native/v6/misc"
 	"github.com/haproxytech/client-native/v6/models"
@@ -34,8 +38,11 @@ import (
 
 // Client handles multiple HAProxy clients
 type client struct {
-	options options.RuntimeOptions
-	runtime *SingleRuntime
+	options    options.RuntimeOptions
+	runtime    *SingleRuntime
+	version    *HAProxyVersion
+	versionSfg singleflight.Group
+	versionMu  sync.RWMutex
 }
 
 const (
@@ -108,63 +115,79 @@ func (c *client) GetInfo() (models.ProcessInfo, error) {
 	return result, nil
 }
 
-var (
-	haproxyVersion *HAProxyVersion        //nolint:gochecknoglobals
-	versionKey     = "version"            //nolint:gochecknoglobals
-	versionSfg     = singleflight.Group{} //nolint:gochecknoglobals
-)
+const versionKey = "version"
 
-// GetVersion returns info from the socket
+// GetVersion returns info from the socket. The version is fetched once per client.
 func (c *client) GetVersion() (HAProxyVersion, error) {
-	var err error
-	if haproxyVersion != nil {
-		return *haproxyVersion, nil
+	if version := c.haproxyVersion(); version != nil {
+		return *version, nil
 	}
-	_, err, _ = versionSfg.Do(versionKey, func() (any, error) {
-		version := &HAProxyVersion{}
-		var response string
+	_, err, _ := c.versionSfg.Do(versionKey, func() (any, error) {
 		if !c.runtime.IsValid() {
-			return HAProxyVersion{}, errors.New("no valid runtime found")
+			return nil, errors.New("no valid runtime found")
 		}
-		response, err = c.runtime.ExecuteRaw("show info")
+		response, err := c.runtime.ExecuteRaw("show info")
 		if err != nil {
-			return HAProxyVersion{}, err
+			return nil, err
 		}
-		for line := range strings.SplitSeq(response, "\n") {
-			versionStr, found := strings.CutPrefix(line, "Version: ")
-			if found {
-				err = version.ParseHAProxyVersion(versionStr)
-				if err != nil {
-					return HAProxyVersion{}, err
-				}
-				haproxyVersion = version
-				return HAProxyVersion{}, err
-			}
-			// Starting with HAProxy 3.0, there is no more "Version:" prefix.
-			if len(line) > 0 && line[0] >= '3' && line[0] <= '9' {
-				err = version.ParseHAProxyVersion(line)
-				if err == nil {
-					haproxyVersion = version
-				}
-				return HAProxyVersion{}, err
-			}
+		version, err := parseShowInfoVersion(response)
+		if err != nil {
+			return nil, err
 		}
-		err = errors.New("version data not found")
-		return HAProxyVersion{}, err // it's dereferenced in IsVersionBiggerOrEqual
+		c.versionMu.Lock()
+		c.version = version
+		c.versionMu.Unlock()
+		return nil, nil
 	})
 	if err != nil {
 		return HAProxyVersion{}, err
 	}
 
-	if haproxyVersion == nil {
+	version := c.haproxyVersion()
+	if version == nil {
 		return HAProxyVersion{}, errors.New("version data not found")
 	}
+	return *version, nil
+}
 
-	return *haproxyVersion, err
+func (c *client) haproxyVersion() *HAProxyVersion {
+	c.versionMu.RLock()
+	defer c.versionMu.RUnlock()
+	return c.version
+}
+
+func parseShowInfoVersion(response string) (*HAProxyVersion, error) {
+	for line := range strings.SplitSeq(response, "\n") {
+		versionStr, found := strings.CutPrefix(line, "Version: ")
+		// Starting with HAProxy 3.0, there is no more "Version:" prefix.
+		if !found && len(line) > 0 && line[0] >= '3' && line[0] <= '9' {
+			versionStr, found = line, true
+		}
+		if found {
+			version := &HAProxyVersion{}
+			if err := version.ParseHAProxyVersion(versionStr); err != nil {
+				return nil, err
+			}
+			return version, nil
+		}
+	}
+	return nil, errors.New("version data not found")
 }
 
 func (c *client) IsVersionBiggerOrEqual(minimumVersion *HAProxyVersion) bool {
-	return IsBiggerOrEqual(minimumVersion, haproxyVersion)
+	return IsBiggerOrEqual(minimumVersion, c.haproxyVersion())
+}
+
+// CheckCapability returns an error when the running HAProxy does not support the capability.
+// When its version cannot be determined, everything is considered supported.
+func (c *client) CheckCapability(kind capabilities.Kind, name string) error {
+	// Init-time version probe is fire-and-forget; retry here so a transient
+	// startup failure doesn't permanently disable the capabilities.
+	version, err := c.GetVersion()
+	if err != nil {
+		return capabilities.Default().Check(nil, kind, name)
+	}
+	return capabilities.Default().Check(version.Version, kind, name)
 }
 
 // Reloads HAProxy's configuration file. Similar to SIGUSR2. Returns the startup logs.
@@ -174,13 +197,8 @@ func (c *client) Reload() (string, error) {
 	if c.options.MasterSocketData == nil {
 		return "", errors.New("cannot reload: not connected to a master socket")
 	}
-	// Init-time version probe is fire-and-forget; retry here so a transient
-	// startup failure doesn't permanently disable the gate.
-	if haproxyVersion == nil {
-		_, _ = c.GetVersion()
-	}
-	if !c.IsVersionBiggerOrEqual(&HAProxyVersion{Version: semver.MustParse("2.7")}) {
-		return "", fmt.Errorf("cannot reload: requires HAProxy 2.7 or later but current version is %v", haproxyVersion)
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdReload); err != nil {
+		return "", fmt.Errorf("cannot reload: %w", err)
 	}
 
 	if !c.runtime.IsValid() {
@@ -211,6 +229,40 @@ func (c *client) Reload() (string, error) {
 	return logs, nil
 }
 
+// ReloadWithDiagnostics reloads HAProxy like Reload, and returns the startup logs parsed,
+// so the configuration lines causing warnings or alerts can be found
+func (c *client) ReloadWithDiagnostics() (diagnostics.Diagnostics, error) {
+	logs, err := c.Reload()
+	return diagnostics.Parse(logs), err
+}
+
+// GetStartupLogs returns the startup logs of the current HAProxy worker from the master CLI
+func (c *client) GetStartupLogs() (string, error) {
+	if c.options.MasterSocketData == nil {
+		return "", errors.New("cannot get startup logs: not connected to a master socket")
+	}
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdShowStartupLogs); err != nil {
+		return "", fmt.Errorf("cannot get startup logs: %w", err)
+	}
+	if !c.runtime.IsValid() {
+		return "", errors.New("cannot get startup logs: no valid runtime found")
+	}
+	logs, err := c.runtime.ExecuteMaster("show startup-logs")
+	if err != nil {
+		return "", fmt.Errorf("cannot get startup logs: %w", err)
+	}
+	return logs, nil
+}
+
+// GetStartupDiagnostics returns the parsed startup logs of the current HAProxy worker
+func (c *client) GetStartupDiagnostics() (diagnostics.Diagnostics, error) {
+	logs, err := c.GetStartupLogs()
+	if err != nil {
+		return nil, err
+	}
+	return diagnostics.Parse(logs), nil
+}
+
 // GetMapsPath returns runtime map file path or map id
 func (c *client) GetMapsPath(name string) (string, error) {
 	// we can refer to runtime map with either id or path
@@ -258,8 +310,8 @@ func (c *client) AddServer(backend, name, attributes string) error {
 	if !c.runtime.IsValid() {
 		return errors.New("no valid runtime found")
 	}
-	if !c.IsVersionBiggerOrEqual(&HAProxyVersion{Version: semver.MustParse("2.6")}) {
-		return fmt.Errorf("this operation requires HAProxy 2.6 or later but current version is %v", haproxyVersion)
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdAddServer); err != nil {
+		return err
 	}
 	err := c.runtime.AddServer(backend, name, attributes)
 	if err != nil {
@@ -273,8 +325,8 @@ func (c *client) DeleteServer(backend, name string) error {
 	if !c.runtime.IsValid() {
 		return errors.New("no valid runtime found")
 	}
-	if !c.IsVersionBiggerOrEqual(&HAProxyVersion{Version: semver.MustParse("2.6")}) {
-		return errors.New("this operation requires HAProxy 2.6 or later")
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdDelServer); err != nil {
+		return err
 	}
 	err := c.runtime.DeleteServer(backend, name)
 	if err != nil {
@@ -455,6 +507,34 @@ func (c *client) GetServerState(backend, server string) (*models.RuntimeServer,
 	return result, nil
 }
 
+// GetServersConnections returns the connection usage and idle pools of the servers of a backend, or of all backends
+func (c *client) GetServersConnections(backend string) (models.ServerConnPools, error) {
+	if !c.runtime.IsValid() {
+		return nil, errors.New("no valid runtime found")
+	}
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdShowServersConn); err != nil {
+		return nil, err
+	}
+	pools, err := c.runtime.GetServersConnections(backend)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", c.runtime.socketPath, err)
+	}
+	return pools, nil
+}
+
+// DumpServersState returns the state of the servers of a backend, or of all backends when backend is empty,
+// in the format of a server-state-file
+func (c *client) DumpServersState(backend string) (string, error) {
+	if !c.runtime.IsValid() {
+		return "", errors.New("no valid runtime found")
+	}
+	state, err := c.runtime.DumpServersState(backend)
+	if err != nil {
+		return "", fmt.Errorf("%s %w", c.runtime.socketPath, err)
+	}
+	return state, nil
+}
+
 // SetServerCheckPort set health check port for server
 func (c *client) SetServerCheckPort(backend, server string, port int) error {
 	if !c.runtime.IsValid() {
@@ -537,6 +617,29 @@ func (c *client) ExecuteRaw(command string) (string, error) {
 	return result, nil
 }
 
+// ExecuteBatch executes commands with as few round trips as possible, several commands being
+// sent at once within HAProxy's buffer limit. A result is returned for each command, and when
+// some of them failed the error is a *BatchError. With HAProxy versions lower than 2.4, or when
+// the version is unknown, commands are sent one by one.
+func (c *client) ExecuteBatch(commands []string) ([]BatchResult, error) {
+	if !c.runtime.IsValid() {
+		return nil, errors.New("no valid runtime found")
+	}
+	if c.CheckCapability(capabilities.Command, capabilities.CmdEcho) != nil {
+		return c.runtime.executeSequentially(commands)
+	}
+	return c.runtime.ExecuteBatch(commands)
+}
+
+// Use adds middlewares wrapping all the commands sent to the runtime API, for example
+// to log them, emit metrics, enforce an allowlist or run in dry-run mode
+func (c *client) Use(middlewares ...Middleware) {
+	if !c.runtime.IsValid() {
+		return
+	}
+	c.runtime.Use(middlewares...)
+}
+
 // ShowMaps returns structured unique map files
 func (c *client) ShowMaps() (models.Maps, error) {
 	if !c.runtime.IsValid() {
@@ -739,11 +842,7 @@ func (c *client) AddMapPayloadVersioned(name string, entries models.MapEntries)
 	if err != nil {
 		return fmt.Errorf("%s %w", c.runtime.socketPath, err)
 	}
-	canAtomicUpdate := false
-	v := HAProxyVersion{Version: semver.MustParse("2.4")}
-	if c.IsVersionBiggerOrEqual(&v) {
-		canAtomicUpdate = true
-	}
+	canAtomicUpdate := c.CheckCapability(capabilities.Command, capabilities.CmdPrepareMap) == nil
 	exceededSize, payload := parseMapPayload(entries, maxBufSize)
 	if canAtomicUpdate && exceededSize {
 		var version string
@@ -982,9 +1081,8 @@ func (c *client) AddACLAtomic(aclID string, entries models.ACLFilesEntries) erro
 	if !c.runtime.IsValid() {
 		return errors.New("no valid runtime found")
 	}
-	v := HAProxyVersion{Version: semver.MustParse("2.4")}
-	if !c.IsVersionBiggerOrEqual(&v) {
-		return fmt.Errorf("not supported for HAProxy versions lower than 2.4 %w", native_errors.ErrGeneral)
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdPrepareACL); err != nil {
+		return fmt.Errorf("%s %w", err.Error(), native_errors.ErrGeneral)
 	}
 	version, err := c.runtime.PrepareACL(aclID)
 	if err != nil {
@@ -1270,6 +1368,9 @@ func (c *client) DumpCertificate(name string) (string, error) {
 	if !c.runtime.IsValid() {
 		return "", errors.New("no valid runtime found")
 	}
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdDumpSSLCert); err != nil {
+		return "", err
+	}
 	pem, err := c.runtime.DumpCertificate(name)
 	if err != nil {
 		return "", fmt.Errorf("%s %w", c.runtime.socketPath, err)
@@ -1489,11 +1590,136 @@ func (c *client) SetRateLimitSSLSessionGlobal(value uint64) error {
 	return nil
 }
 
+// ShowTLSKeys returns the TLS session ticket keys files used by the binds
+func (c *client) ShowTLSKeys() (models.TLSTicketKeysFiles, error) {
+	if !c.runtime.IsValid() {
+		return nil, errors.New("no valid runtime found")
+	}
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdShowTLSKeys); err != nil {
+		return nil, err
+	}
+	files, err := c.runtime.ShowTLSKeys()
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", c.runtime.socketPath, err)
+	}
+	return files, nil
+}
+
+// ShowTLSKeysEntries returns the keys of a TLS session ticket keys file, "*" for all files
+func (c *client) ShowTLSKeysEntries(id string) (models.TLSTicketKeys, error) {
+	if !c.runtime.IsValid() {
+		return nil, errors.New("no valid runtime found")
+	}
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdShowTLSKeys); err != nil {
+		return nil, err
+	}
+	keys, err := c.runtime.ShowTLSKeysEntries(id)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", c.runtime.socketPath, err)
+	}
+	return keys, nil
+}
+
+// SetTLSKey sets the next TLS session ticket key of a keys file
+func (c *client) SetTLSKey(id, key string) error {
+	if !c.runtime.IsValid() {
+		return errors.New("no valid runtime found")
+	}
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdSetSSLTLSKey); err != nil {
+		return err
+	}
+	if err := c.runtime.SetTLSKey(id, key); err != nil {
+		return fmt.Errorf("%s %w", c.runtime.socketPath, err)
+	}
+	return nil
+}
+
+// RotateTLSKeys sets a newly generated key on every TLS session ticket keys file.
+// Generated keys have the same size as the keys already loaded from the file.
+func (c *client) RotateTLSKeys() error {
+	files, err := c.ShowTLSKeys()
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if file == nil || file.ID == nil {
+			continue
+		}
+		id := strconv.FormatInt(*file.ID, 10)
+		size := TLSTicketKeySizeAES128
+		keys, err := c.ShowTLSKeysEntries(id)
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if raw, err := base64.StdEncoding.DecodeString(k.Key); err == nil && len(raw) == TLSTicketKeySizeAES256 {
+				size = TLSTicketKeySizeAES256
+				break
+			}
+		}
+		key, err := GenerateTLSTicketKey(size)
+		if err != nil {
+			return err
+		}
+		if err = c.SetTLSKey(id, key); err != nil {
+			return fmt.Errorf("tls-keys %s (%s): %w", id, file.File, err)
+		}
+	}
+	return nil
+}
+
+// ShowQuic returns the QUIC connections with their streams, including closing ones when all is true
+func (c *client) ShowQuic(all bool) (models.QuicConnections, error) {
+	if !c.runtime.IsValid() {
+		return nil, errors.New("no valid runtime found")
+	}
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdShowQuic); err != nil {
+		return nil, err
+	}
+	connections, err := c.runtime.ShowQuic(all)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", c.runtime.socketPath, err)
+	}
+	return connections, nil
+}
+
+// ShowCaches returns the caches with their usage and entries
+func (c *client) ShowCaches() (models.RuntimeCaches, error) {
+	if !c.runtime.IsValid() {
+		return nil, errors.New("no valid runtime found")
+	}
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdShowCache); err != nil {
+		return nil, err
+	}
+	caches, err := c.runtime.ShowCaches()
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", c.runtime.socketPath, err)
+	}
+	return caches, nil
+}
+
+// GetCache returns a cache with its usage and entries
+func (c *client) GetCache(name string) (*models.RuntimeCache, error) {
+	caches, err := c.ShowCaches()
+	if err != nil {
+		return nil, err
+	}
+	for _, cache := range caches {
+		if cache.Name == name {
+			return cache, nil
+		}
+	}
+	return nil, fmt.Errorf("%s cache %s %w", c.runtime.socketPath, name, native_errors.ErrNotFound)
+}
+
 // AcmeRenew forces the immediate renewal of a certificate.
 func (c *client) AcmeRenew(certificate string) error {
 	if !c.runtime.IsValid() {
 		return errors.New("no valid runtime found")
 	}
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdAcmeRenew); err != nil {
+		return err
+	}
 	if err := c.runtime.AcmeRenew(certificate); err != nil {
 		return fmt.Errorf("%s %w", c.runtime.socketPath, err)
 	}
@@ -1505,6 +1731,9 @@ func (c *client) AcmeStatus() (models.AcmeStatus, error) {
 	if !c.runtime.IsValid() {
 		return nil, errors.New("no valid runtime found")
 	}
+	if err := c.CheckCapability(capabilities.Command, capabilities.CmdAcmeStatus); err != nil {
+		return nil, err
+	}
 	status, err := c.runtime.AcmeStatus()
 	if err != nil {
 		return nil, fmt.Errorf("%s %w", c.runtime.socketPath, err)
@@ -16,10 +16,15 @@
 package runtime
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	"github.com/haproxytech/client-native/v6/capabilities"
 	"github.com/haproxytech/client-native/v6/models"
+	"github.com/haproxytech/client-native/v6/runtime/options"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_parseMapPayload(t *testing.T) {
@@ -78,3 +83,46 @@ func Test_parseMapPayload(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetVersionPerClient(t *testing.T) {
+	oldHAProxy := NewHAProxyMock(t)
+	oldHAProxy.Start()
+	defer oldHAProxy.Stop()
+	oldHAProxy.SetResponses(&map[string]string{"show info\n": "Name: HAProxy\nVersion: 2.4.22\n"})
+
+	newHAProxy := NewHAProxyMock(t)
+	newHAProxy.Start()
+	defer newHAProxy.Stop()
+	newHAProxy.SetResponses(&map[string]string{"show info\n": "3.2.0-dev6-2f6f36-13\n"})
+
+	oldClient, err := New(context.Background(), options.Socket(oldHAProxy.Addr().String()))
+	require.NoError(t, err)
+	newClient, err := New(context.Background(), options.Socket(newHAProxy.Addr().String()))
+	require.NoError(t, err)
+
+	oldVersion, err := oldClient.GetVersion()
+	require.NoError(t, err)
+	require.Equal(t, "2.4.22", oldVersion.String())
+	newVersion, err := newClient.GetVersion()
+	require.NoError(t, err)
+	require.Equal(t, "3.2.0-dev6-2f6f36-13", newVersion.String())
+
+	require.True(t, errors.Is(oldClient.CheckCapability(capabilities.Command, capabilities.CmdAddServer), capabilities.ErrUnsupported))
+	require.NoError(t, oldClient.CheckCapability(capabilities.Command, capabilities.CmdPrepareMap))
+	require.NoError(t, newClient.CheckCapability(capabilities.Command, capabilities.CmdAddServer))
+	require.NoError(t, newClient.CheckCapability(capabilities.Command, capabilities.CmdAcmeStatus))
+
+	_, err = oldClient.ShowQuic(false)
+	require.ErrorIs(t, err, capabilities.ErrUnsupported)
+	_, err = oldClient.DumpCertificate("site.pem")
+	require.ErrorIs(t, err, capabilities.ErrUnsupported)
+
+	// everything is supported when the version is unknown
+	unknownHAProxy := NewHAProxyMock(t)
+	unknownHAProxy.Start()
+	defer unknownHAProxy.Stop()
+	unknownHAProxy.SetResponses(&map[string]string{"show info\n": "Name: HAProxy\n"})
+	unknownClient, err := New(context.Background(), options.Socket(unknownHAProxy.Addr().String()))
+	require.NoError(t, err)
+	require.NoError(t, unknownClient.CheckCapability(capabilities.Command, capabilities.CmdAcmeStatus))
+}
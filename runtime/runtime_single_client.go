@@ -40,8 +40,10 @@ type socketType string
 // SingleRuntime handles one runtime API
 type SingleRuntime struct {
 	socketPath       string
+	middlewares      []Middleware
 	masterWorkerMode bool
 	mtx              sync.Mutex
+	middlewaresMtx   sync.RWMutex
 }
 
 func (s *SingleRuntime) IsValid() bool {
@@ -193,11 +195,30 @@ func (s *SingleRuntime) ExecuteMaster(command string) (string, error) {
 	return s.executeRaw(command, 1, masterSocket)
 }
 
+// Use adds middlewares wrapping the commands sent to the runtime API.
+// The first middleware added is the outermost one.
+func (s *SingleRuntime) Use(middlewares ...Middleware) {
+	s.middlewaresMtx.Lock()
+	defer s.middlewaresMtx.Unlock()
+	s.middlewares = append(s.middlewares, middlewares...)
+}
+
 func (s *SingleRuntime) executeRaw(command string, retry int, socket socketType) (string, error) {
+	s.middlewaresMtx.RLock()
+	middlewares := s.middlewares
+	s.middlewaresMtx.RUnlock()
+
+	handler := chain(func(cmd Command) (string, error) {
+		return s.readFromSocketWithRetry(cmd.Command, retry, socket)
+	}, middlewares)
+	return handler(Command{Command: command, SocketPath: s.socketPath, Master: socket == masterSocket})
+}
+
+func (s *SingleRuntime) readFromSocketWithRetry(command string, retry int, socket socketType) (string, error) {
 	result, err := s.readFromSocket(command, socket)
 	if err != nil && retry > 0 {
 		retry--
-		return s.executeRaw(command, retry, socket)
+		return s.readFromSocketWithRetry(command, retry, socket)
 	}
 	return result, err
 }
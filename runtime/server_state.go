@@ -0,0 +1,100 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package runtime
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	native_errors "github.com/haproxytech/client-native/v6/errors"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+// serverStateMinFields is the number of fields of a server in the version 1 format
+const serverStateMinFields = 25
+
+// DumpServersState returns the output of `show servers state` for a backend, or for all
+// backends when backend is empty, in the format of a server-state-file
+func (s *SingleRuntime) DumpServersState(backend string) (string, error) {
+	cmd := strings.TrimSpace("show servers state " + backend)
+	result, err := s.ExecuteWithResponse(cmd)
+	if err != nil {
+		return "", err
+	}
+	if err = ValidateServerState(result); err != nil {
+		return "", err
+	}
+	return result + "\n", nil
+}
+
+// ValidateServerState checks that data is a valid server state, as dumped by `show servers state`:
+//
+//	1
+//	# be_id be_name srv_id srv_name srv_addr srv_op_state srv_admin_state srv_uweight srv_iweight ...
+//	3 be_app 1 srv1 10.0.0.1 2 0 1 1 120 6 3 4 6 0 0 0 - 8080 - 0 0 - - 0
+func ValidateServerState(data string) error {
+	lines := strings.Split(strings.TrimSpace(data), "\n")
+	if strings.TrimSpace(lines[0]) != "1" {
+		return fmt.Errorf("unsupported server state format version '%s', supporting format version 1 %w", strings.TrimSpace(lines[0]), native_errors.ErrGeneral)
+	}
+	columns := 0
+	for i, line := range lines[1:] {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if header, found := strings.CutPrefix(line, "#"); found {
+			if columns == 0 {
+				columns = len(strings.Fields(header))
+			}
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < serverStateMinFields || (columns != 0 && len(fields) != columns) {
+			return fmt.Errorf("invalid server state on line %d: %d fields %w", i+2, len(fields), native_errors.ErrGeneral)
+		}
+		for _, idx := range []int{0, 2, 5, 6, 7, 8} {
+			if _, err := strconv.ParseInt(fields[idx], 10, 64); err != nil {
+				return fmt.Errorf("invalid server state on line %d: field %d '%s' is not a number %w", i+2, idx+1, fields[idx], native_errors.ErrGeneral)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseServerState returns the servers of a server state, as dumped by `show servers state`
+func ParseServerState(data string) (models.RuntimeServers, error) {
+	data = strings.TrimSpace(data)
+	if err := ValidateServerState(data); err != nil {
+		return nil, err
+	}
+	return parseRuntimeServers(data)
+}
+
+// ReadServerStateFile returns the servers of a server-state-file
+func ReadServerStateFile(path string) (models.RuntimeServers, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	servers, err := ParseServerState(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return servers, nil
+}
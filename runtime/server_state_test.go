@@ -0,0 +1,51 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package runtime
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testServerState = `1
+# be_id be_name srv_id srv_name srv_addr srv_op_state srv_admin_state srv_uweight srv_iweight srv_time_since_last_change srv_check_status srv_check_result srv_check_health srv_check_state srv_agent_state bk_f_forced_id srv_f_forced_id srv_fqdn srv_port srvrecord srv_use_ssl srv_check_port srv_check_addr srv_agent_addr srv_agent_port
+3 be_app 1 srv1 10.0.0.1 2 0 1 1 120 6 3 4 6 0 0 0 - 8080 - 0 0 - - 0
+3 be_app 2 srv2 10.0.0.2 0 1 1 1 30 6 3 0 6 0 0 0 - 8080 - 0 0 - - 0
+`
+
+func TestValidateServerState(t *testing.T) {
+	require.NoError(t, ValidateServerState(testServerState))
+	require.Error(t, ValidateServerState("2\n"))
+	require.Error(t, ValidateServerState("1\n3 be_app 1 srv1 10.0.0.1 2 0\n"))
+	require.Error(t, ValidateServerState("1\n3 be_app x srv1 10.0.0.1 2 0 1 1 120 6 3 4 6 0 0 0 - 8080 - 0 0 - - 0\n"))
+}
+
+func TestReadServerStateFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state")
+	require.NoError(t, os.WriteFile(path, []byte(testServerState), 0o600))
+
+	servers, err := ReadServerStateFile(path)
+	require.NoError(t, err)
+	require.Len(t, servers, 2)
+	require.Equal(t, "be_app", servers[0].BackendName)
+	require.Equal(t, "srv1", servers[0].Name)
+	require.Equal(t, "up", servers[0].OperationalState)
+	require.Equal(t, "down", servers[1].OperationalState)
+	require.Equal(t, "maint", servers[1].AdminState)
+}
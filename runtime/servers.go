@@ -271,3 +271,90 @@ func parseRuntimeServer(line string) *models.RuntimeServer {
 		AgentPort:        agentPort,
 	}
 }
+
+// GetServersConnections returns the connection usage and idle pools of the servers,
+// with the idle connections of each thread. All backends are returned when backend is empty.
+func (s *SingleRuntime) GetServersConnections(backend string) (models.ServerConnPools, error) {
+	cmd := strings.TrimSpace("show servers conn " + backend)
+	result, err := s.ExecuteWithResponse(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return parseServerConnPools(result)
+}
+
+// parseServerConnPools parses the output of `show servers conn`:
+//
+//	# bkname/svname bkid/svid addr port - purge_delay used_cur used_max need_est unsafe_nb safe_nb idle_lim idle_cur idle_per_thr[2]
+//	be_app/srv1 3/1 10.0.0.1 8080 - 5000 1 4 3 0 2 -1 2 1 1
+func parseServerConnPools(output string) (models.ServerConnPools, error) {
+	result := models.ServerConnPools{}
+	var columns []string
+	for line := range strings.SplitSeq(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			columns = strings.Fields(strings.TrimPrefix(line, "#"))
+			continue
+		}
+		if columns == nil {
+			return nil, fmt.Errorf("unexpected show servers conn output: %s", line)
+		}
+		pool, err := parseServerConnPool(columns, strings.Fields(line))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, pool)
+	}
+	return result, nil
+}
+
+func parseServerConnPool(columns, fields []string) (*models.ServerConnPool, error) {
+	pool := &models.ServerConnPool{}
+	for i, column := range columns {
+		if i >= len(fields) {
+			break
+		}
+		value := fields[i]
+		switch {
+		case column == "bkname/svname":
+			pool.Backend, pool.Name, _ = strings.Cut(value, "/")
+		case column == "bkid/svid":
+			bkID, svID, _ := strings.Cut(value, "/")
+			pool.BackendID = parseInt64P(bkID)
+			pool.ID = parseInt64P(svID)
+		case column == "addr":
+			pool.Address = value
+		case column == "port":
+			pool.Port = parseInt64P(value)
+		case column == "purge_delay":
+			pool.PurgeDelay = parseInt64P(value)
+		case column == "used_cur":
+			pool.UsedCur = parseInt64P(value)
+		case column == "used_max":
+			pool.UsedMax = parseInt64P(value)
+		case column == "need_est":
+			pool.NeedEst = parseInt64P(value)
+		case column == "unsafe_nb":
+			pool.UnsafeIdle = parseInt64P(value)
+		case column == "safe_nb":
+			pool.SafeIdle = parseInt64P(value)
+		case column == "idle_lim":
+			pool.IdleLimit = parseInt64P(value)
+		case column == "idle_cur":
+			pool.IdleCur = parseInt64P(value)
+		case strings.HasPrefix(column, "idle_per_thr"):
+			pool.IdlePerThread = make([]int64, 0, len(fields)-i)
+			for _, v := range fields[i:] {
+				n, err := strconv.ParseInt(v, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid idle connections count '%s' for %s/%s", v, pool.Backend, pool.Name)
+				}
+				pool.IdlePerThread = append(pool.IdlePerThread, n)
+			}
+		}
+	}
+	return pool, nil
+}
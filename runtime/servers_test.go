@@ -0,0 +1,54 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package runtime
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/misc"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+func TestParseServerConnPools(t *testing.T) {
+	output := `# bkname/svname bkid/svid addr port - purge_delay used_cur used_max need_est unsafe_nb safe_nb idle_lim idle_cur idle_per_thr[2]
+be_app/srv1 3/1 10.0.0.1 8080 - 5000 1 4 3 0 2 -1 2 1 1
+be_app/srv2 3/2 10.0.0.2 8080 - 5000 0 0 0 0 0 10 0 0 0
+`
+	want := models.ServerConnPools{
+		&models.ServerConnPool{
+			Backend: "be_app", Name: "srv1", BackendID: misc.Int64P(3), ID: misc.Int64P(1),
+			Address: "10.0.0.1", Port: misc.Int64P(8080), PurgeDelay: misc.Int64P(5000),
+			UsedCur: misc.Int64P(1), UsedMax: misc.Int64P(4), NeedEst: misc.Int64P(3),
+			UnsafeIdle: misc.Int64P(0), SafeIdle: misc.Int64P(2), IdleLimit: misc.Int64P(-1), IdleCur: misc.Int64P(2),
+			IdlePerThread: []int64{1, 1},
+		},
+		&models.ServerConnPool{
+			Backend: "be_app", Name: "srv2", BackendID: misc.Int64P(3), ID: misc.Int64P(2),
+			Address: "10.0.0.2", Port: misc.Int64P(8080), PurgeDelay: misc.Int64P(5000),
+			UsedCur: misc.Int64P(0), UsedMax: misc.Int64P(0), NeedEst: misc.Int64P(0),
+			UnsafeIdle: misc.Int64P(0), SafeIdle: misc.Int64P(0), IdleLimit: misc.Int64P(10), IdleCur: misc.Int64P(0),
+			IdlePerThread: []int64{0, 0},
+		},
+	}
+	got, err := parseServerConnPools(output)
+	if err != nil {
+		t.Fatalf("parseServerConnPools() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseServerConnPools() = %+v, want %+v", got, want)
+	}
+}
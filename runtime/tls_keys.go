@@ -0,0 +1,187 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package runtime
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	native_errors "github.com/haproxytech/client-native/v6/errors"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+// Sizes of the TLS session ticket keys accepted by HAProxy
+const (
+	// TLSTicketKeySizeAES128 is the size of a key for AES-128 tickets
+	TLSTicketKeySizeAES128 = 48
+	// TLSTicketKeySizeAES256 is the size of a key for AES-256 tickets
+	TLSTicketKeySizeAES256 = 80
+)
+
+// GenerateTLSTicketKey returns a new random TLS session ticket key of the given size,
+// base64 encoded as expected by tls-ticket-keys files and `set ssl tls-key`
+func GenerateTLSTicketKey(size int) (string, error) {
+	if size != TLSTicketKeySizeAES128 && size != TLSTicketKeySizeAES256 {
+		return "", fmt.Errorf("invalid TLS ticket key size %d, must be %d or %d %w", size, TLSTicketKeySizeAES128, TLSTicketKeySizeAES256, native_errors.ErrGeneral)
+	}
+	key := make([]byte, size)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// ShowTLSKeys returns the TLS session ticket keys files used by the binds
+func (s *SingleRuntime) ShowTLSKeys() (models.TLSTicketKeysFiles, error) {
+	response, err := s.ExecuteWithResponse("show tls-keys")
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", err.Error(), native_errors.ErrNotFound)
+	}
+	return parseTLSKeysFiles(response)
+}
+
+// parseTLSKeysFiles parses the output of `show tls-keys`:
+//
+//	# id (file)
+//	0 (/etc/haproxy/tls-ticket-keys)
+func parseTLSKeysFiles(response string) (models.TLSTicketKeysFiles, error) {
+	files := models.TLSTicketKeysFiles{}
+	for line := range strings.SplitSeq(response, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		file, err := parseTLSKeysFileLine(line)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func parseTLSKeysFileLine(line string) (*models.TLSTicketKeysFile, error) {
+	idStr, file, found := strings.Cut(line, " ")
+	if !found {
+		return nil, fmt.Errorf("failed to parse tls-keys line '%s' %w", line, native_errors.ErrGeneral)
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tls-keys id '%s' %w", idStr, native_errors.ErrGeneral)
+	}
+	file = strings.TrimSpace(file)
+	file = strings.TrimSuffix(strings.TrimPrefix(file, "("), ")")
+	return &models.TLSTicketKeysFile{ID: &id, File: file}, nil
+}
+
+// ShowTLSKeysEntries returns the keys of a TLS session ticket keys file, identified
+// by its id or its path. Use "*" to get the keys of all files.
+func (s *SingleRuntime) ShowTLSKeysEntries(id string) (models.TLSTicketKeys, error) {
+	if id == "" {
+		return nil, fmt.Errorf("%s %w", "Argument id empty", native_errors.ErrGeneral)
+	}
+	response, err := s.ExecuteWithResponse("show tls-keys " + id)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", err.Error(), native_errors.ErrNotFound)
+	}
+	return parseTLSKeys(response)
+}
+
+// parseTLSKeys parses the output of `show tls-keys <id>`:
+//
+//	# id secret
+//	# 0 (/etc/haproxy/tls-ticket-keys)
+//	0.0 ZHl6jDUXS9Im0V0D0nrIFQm6Ts3+nDyUfB6TlLgBzjGrfNoA8V84skrBA6eKc/Tu
+//	0.1 5O6ZbNgJVpZb1lPDLy2mcgXe44Z0KVZbfZ8VnwjlA4yU6W7OTEJb7EGdMa6PwQwA
+//	0.2 5rqhGEBG03Os7NTpQCIuAXNPDWWhqbNI3lGfJ5J5d6TaX4UjGsy5N9CpbQ1g0fDm
+func parseTLSKeys(response string) (models.TLSTicketKeys, error) {
+	if strings.Contains(response, "not found") || strings.Contains(response, "Can't") {
+		return nil, fmt.Errorf("%s %w", strings.TrimSpace(response), native_errors.ErrNotFound)
+	}
+
+	keys := models.TLSTicketKeys{}
+	files := map[string]string{}
+	for line := range strings.SplitSeq(response, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if line[0] == '#' {
+			if file, err := parseTLSKeysFileLine(strings.TrimSpace(line[1:])); err == nil {
+				files[strconv.FormatInt(*file.ID, 10)] = file.File
+			}
+			continue
+		}
+		id, key, found := strings.Cut(line, " ")
+		if !found {
+			return nil, fmt.Errorf("failed to parse tls-keys line '%s' %w", line, native_errors.ErrGeneral)
+		}
+		fileID, slotStr, found := strings.Cut(id, ".")
+		if !found {
+			return nil, fmt.Errorf("failed to parse tls-keys id '%s' %w", id, native_errors.ErrGeneral)
+		}
+		slot, err := strconv.ParseInt(slotStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tls-keys id '%s' %w", id, native_errors.ErrGeneral)
+		}
+		keys = append(keys, &models.TLSTicketKey{
+			ID:   id,
+			File: files[fileID],
+			Slot: &slot,
+			Key:  strings.TrimSpace(key),
+		})
+	}
+	return keys, nil
+}
+
+// SetTLSKey sets the next TLS session ticket key of a keys file, identified by its id
+// or its path. The new key becomes the last one, the previous last key is used for
+// encryption and the oldest key is dropped.
+func (s *SingleRuntime) SetTLSKey(id, key string) error {
+	if id == "" || key == "" {
+		return fmt.Errorf("%s %w", "Arguments id and key are required", native_errors.ErrGeneral)
+	}
+	response, err := s.ExecuteWithResponse(fmt.Sprintf("set ssl tls-key %s %s", id, key))
+	if err != nil {
+		return fmt.Errorf("%s %w", err.Error(), native_errors.ErrGeneral)
+	}
+	if !strings.Contains(response, "TLS ticket key updated") {
+		return fmt.Errorf("%s %w", strings.TrimSpace(response), native_errors.ErrGeneral)
+	}
+	return nil
+}
+
+// ScheduleTLSKeyRotation rotates the TLS session ticket keys of all files every interval,
+// until ctx is done. Rotation errors are passed to onError when it is set.
+func ScheduleTLSKeyRotation(ctx context.Context, rt Runtime, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := rt.RotateTLSKeys(); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
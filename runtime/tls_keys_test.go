@@ -0,0 +1,101 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package runtime
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/misc"
+	"github.com/haproxytech/client-native/v6/models"
+)
+
+func TestSingleRuntime_ShowTLSKeys(t *testing.T) {
+	haProxy := NewHAProxyMock(t)
+	haProxy.Start()
+	defer haProxy.Stop()
+
+	haProxy.SetResponses(&map[string]string{
+		"show tls-keys\n": `# id (file)
+0 (/etc/haproxy/tls-ticket-keys)
+1 (/etc/haproxy/other-keys)
+`,
+		"show tls-keys 0\n": `# id secret
+# 0 (/etc/haproxy/tls-ticket-keys)
+0.0 ZHl6jDUXS9Im0V0D0nrIFQm6Ts3+nDyUfB6TlLgBzjGrfNoA8V84skrBA6eKc/Tu
+0.1 5O6ZbNgJVpZb1lPDLy2mcgXe44Z0KVZbfZ8VnwjlA4yU6W7OTEJb7EGdMa6PwQwA
+`,
+		"set ssl tls-key 0 ZHl6jDUXS9Im0V0D0nrIFQm6Ts3+nDyUfB6TlLgBzjGrfNoA8V84skrBA6eKc/Tu\n": "TLS ticket key updated!\n",
+		"set ssl tls-key 5 ZHl6jDUXS9Im0V0D0nrIFQm6Ts3+nDyUfB6TlLgBzjGrfNoA8V84skrBA6eKc/Tu\n": "'set ssl tls-key' unable to locate referenced filename\n",
+	})
+
+	s := &SingleRuntime{}
+	if err := s.Init(haProxy.Addr().String(), false); err != nil {
+		t.Fatalf("SingleRuntime.Init() error = %v", err)
+	}
+
+	files, err := s.ShowTLSKeys()
+	if err != nil {
+		t.Fatalf("SingleRuntime.ShowTLSKeys() error = %v", err)
+	}
+	wantFiles := models.TLSTicketKeysFiles{
+		&models.TLSTicketKeysFile{ID: misc.Int64P(0), File: "/etc/haproxy/tls-ticket-keys"},
+		&models.TLSTicketKeysFile{ID: misc.Int64P(1), File: "/etc/haproxy/other-keys"},
+	}
+	if !reflect.DeepEqual(files, wantFiles) {
+		t.Errorf("SingleRuntime.ShowTLSKeys() = %v, want %v", files, wantFiles)
+	}
+
+	keys, err := s.ShowTLSKeysEntries("0")
+	if err != nil {
+		t.Fatalf("SingleRuntime.ShowTLSKeysEntries() error = %v", err)
+	}
+	wantKeys := models.TLSTicketKeys{
+		&models.TLSTicketKey{ID: "0.0", File: "/etc/haproxy/tls-ticket-keys", Slot: misc.Int64P(0), Key: "ZHl6jDUXS9Im0V0D0nrIFQm6Ts3+nDyUfB6TlLgBzjGrfNoA8V84skrBA6eKc/Tu"},
+		&models.TLSTicketKey{ID: "0.1", File: "/etc/haproxy/tls-ticket-keys", Slot: misc.Int64P(1), Key: "5O6ZbNgJVpZb1lPDLy2mcgXe44Z0KVZbfZ8VnwjlA4yU6W7OTEJb7EGdMa6PwQwA"},
+	}
+	if !reflect.DeepEqual(keys, wantKeys) {
+		t.Errorf("SingleRuntime.ShowTLSKeysEntries() = %v, want %v", keys, wantKeys)
+	}
+
+	if err = s.SetTLSKey("0", "ZHl6jDUXS9Im0V0D0nrIFQm6Ts3+nDyUfB6TlLgBzjGrfNoA8V84skrBA6eKc/Tu"); err != nil {
+		t.Errorf("SingleRuntime.SetTLSKey() error = %v", err)
+	}
+	if err = s.SetTLSKey("5", "ZHl6jDUXS9Im0V0D0nrIFQm6Ts3+nDyUfB6TlLgBzjGrfNoA8V84skrBA6eKc/Tu"); err == nil {
+		t.Error("SingleRuntime.SetTLSKey() expected error for unknown file")
+	}
+}
+
+func TestGenerateTLSTicketKey(t *testing.T) {
+	for _, size := range []int{TLSTicketKeySizeAES128, TLSTicketKeySizeAES256} {
+		key, err := GenerateTLSTicketKey(size)
+		if err != nil {
+			t.Fatalf("GenerateTLSTicketKey(%d) error = %v", size, err)
+		}
+		raw, err := base64.StdEncoding.DecodeString(key)
+		if err != nil || len(raw) != size {
+			t.Errorf("GenerateTLSTicketKey(%d) = %s, decoded length %d", size, key, len(raw))
+		}
+		other, _ := GenerateTLSTicketKey(size)
+		if other == key {
+			t.Errorf("GenerateTLSTicketKey(%d) returned the same key twice", size)
+		}
+	}
+	if _, err := GenerateTLSTicketKey(32); err == nil {
+		t.Error("GenerateTLSTicketKey(32) expected error")
+	}
+}
@@ -0,0 +1,487 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package clientnative
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/haproxytech/client-native/v6/config-parser/params"
+	"github.com/haproxytech/client-native/v6/configuration"
+	"github.com/haproxytech/client-native/v6/configuration/options"
+	"github.com/haproxytech/client-native/v6/models"
+	"github.com/haproxytech/client-native/v6/runtime"
+)
+
+// ServerChangeAction describes the kind of change found for a server
+type ServerChangeAction string
+
+const (
+	ServerChangeAdd    ServerChangeAction = "add"
+	ServerChangeDelete ServerChangeAction = "delete"
+	ServerChangeUpdate ServerChangeAction = "update"
+)
+
+// agent_state bit set by HAProxy when the agent check is enabled
+const agentCheckEnabled = 0x04
+
+// runtimeServerFields are the server fields the reconciler applies
+// through the runtime API, keyed by their models.Server Diff name.
+var runtimeServerFields = map[string]struct{}{ //nolint:gochecknoglobals
+	"Address":                      {},
+	"Port":                         {},
+	"ID":                           {},
+	"Name":                         {},
+	"ServerParams.Weight":          {},
+	"ServerParams.Maintenance":     {},
+	"ServerParams.AgentCheck":      {},
+	"ServerParams.AgentAddr":       {},
+	"ServerParams.AgentSend":       {},
+	"ServerParams.Ssl":             {},
+	"ServerParams.HealthCheckPort": {},
+}
+
+// ServerChange is a single difference between a configured server and its runtime state
+type ServerChange struct {
+	// Err is set when the runtime API refused the change
+	Err     error
+	Backend string
+	Server  string
+	Action  ServerChangeAction
+	// Field is the server attribute that differs, empty for add and delete
+	Field string
+	From  string
+	To    string
+}
+
+func (c ServerChange) String() string {
+	var s string
+	switch c.Action {
+	case ServerChangeUpdate:
+		s = fmt.Sprintf("update %s/%s %s: '%s' -> '%s'", c.Backend, c.Server, c.Field, c.From, c.To)
+	default:
+		s = fmt.Sprintf("%s %s/%s", c.Action, c.Backend, c.Server)
+	}
+	if c.Err != nil {
+		s += ": " + c.Err.Error()
+	}
+	return s
+}
+
+// ServerReconcileResult holds the outcome of a reconciliation
+type ServerReconcileResult struct {
+	// Applied are the changes successfully applied through the runtime API
+	Applied []ServerChange
+	// ReloadRequired are the changes that could not be applied at runtime,
+	// either because HAProxy does not support them or because the command failed
+	ReloadRequired []ServerChange
+}
+
+// NeedsReload returns true if at least one change must be applied with a reload
+func (r *ServerReconcileResult) NeedsReload() bool {
+	return len(r.ReloadRequired) > 0
+}
+
+func (r *ServerReconcileResult) merge(o *ServerReconcileResult) {
+	r.Applied = append(r.Applied, o.Applied...)
+	r.ReloadRequired = append(r.ReloadRequired, o.ReloadRequired...)
+}
+
+// ServerReconciler applies the differences between the servers of the committed
+// configuration and the servers running in HAProxy through the runtime API.
+//
+// Address, port, weight, maintenance, agent, ssl and check port differences are
+// read from `show servers state` and applied live, missing servers are created
+// with `add server` and servers absent from the configuration are removed with
+// `del server`. Other server parameters are not exposed by the runtime API, so they
+// are compared with the configuration known to be running and reported as requiring
+// a reload. The configuration committed when the reconciler is created is known to be
+// running, Reloaded records the configuration running after each reload.
+type ServerReconciler struct {
+	configuration configuration.Configuration
+	runtime       runtime.Runtime
+	// configuration known to be running, per backend and server name
+	reconciled map[string]map[string]models.Server
+	mu         sync.Mutex
+}
+
+// NewServerReconciler returns a reconciler working on the given configuration and runtime clients
+func NewServerReconciler(configurationClient configuration.Configuration, runtimeClient runtime.Runtime) *ServerReconciler {
+	r := &ServerReconciler{
+		configuration: configurationClient,
+		runtime:       runtimeClient,
+		reconciled:    make(map[string]map[string]models.Server),
+	}
+	// when the committed configuration cannot be read, none is known to be running
+	// and the parameters set in the configuration are all reported
+	_ = r.Reloaded()
+	return r
+}
+
+// Reconcile reconciles the servers of every backend of the committed configuration
+func (r *ServerReconciler) Reconcile() (*ServerReconcileResult, error) {
+	_, backends, err := r.configuration.GetBackends("")
+	if err != nil {
+		return nil, err
+	}
+	result := &ServerReconcileResult{}
+	for _, b := range backends {
+		res, err := r.ReconcileBackend(b.Name)
+		if err != nil {
+			return result, err
+		}
+		result.merge(res)
+	}
+	return result, nil
+}
+
+// Reloaded records that HAProxy runs the servers of the committed configuration, after a reload
+func (r *ServerReconciler) Reloaded() error {
+	_, backends, err := r.configuration.GetBackends("")
+	if err != nil {
+		return err
+	}
+	reconciled := make(map[string]map[string]models.Server, len(backends))
+	for _, b := range backends {
+		_, servers, err := r.configuration.GetServers(configuration.BackendParentName, b.Name, "")
+		if err != nil {
+			return err
+		}
+		reconciled[b.Name] = make(map[string]models.Server, len(servers))
+		for _, s := range servers {
+			reconciled[b.Name][s.Name] = *s
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reconciled = reconciled
+	return nil
+}
+
+// ReconcileBackend reconciles the servers of one backend of the committed configuration
+func (r *ServerReconciler) ReconcileBackend(backend string) (*ServerReconcileResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, servers, err := r.configuration.GetServers(configuration.BackendParentName, backend, "")
+	if err != nil {
+		return nil, err
+	}
+	_, templates, err := r.configuration.GetServerTemplates(backend, "")
+	if err != nil {
+		return nil, err
+	}
+
+	configured := make(map[string]models.Server, len(servers))
+	for _, s := range servers {
+		configured[s.Name] = *s
+	}
+
+	result := &ServerReconcileResult{}
+	states, err := r.runtime.GetServersState(backend)
+	if err != nil {
+		// backend unknown to the running process, it can only come with a reload
+		for _, name := range sortedServerNames(configured) {
+			result.ReloadRequired = append(result.ReloadRequired, ServerChange{Backend: backend, Server: name, Action: ServerChangeAdd, Err: err})
+		}
+		return result, nil
+	}
+
+	running := make(map[string]*models.RuntimeServer, len(states))
+	for _, s := range states {
+		if s != nil {
+			running[s.Name] = s
+		}
+	}
+
+	// the running configuration only moves forward with the changes applied
+	previous := r.reconciled[backend]
+	reconciled := make(map[string]models.Server, len(configured))
+	for _, name := range sortedServerNames(configured) {
+		server := configured[name]
+		state, ok := running[name]
+		if !ok {
+			if r.addServer(result, backend, server) {
+				server.ServerParams = *server.PrepareFieldsForRuntimeAddServer()
+				reconciled[name] = server
+			}
+			continue
+		}
+		last, known := previous[name]
+		reconciled[name] = r.updateServer(result, backend, server, state, last, known)
+	}
+
+	for name, state := range running {
+		if _, ok := configured[name]; ok || isTemplateServer(name, templates) {
+			continue
+		}
+		if !r.deleteServer(result, backend, state) {
+			if last, ok := previous[name]; ok {
+				reconciled[name] = last
+			}
+		}
+	}
+	r.reconciled[backend] = reconciled
+
+	return result, nil
+}
+
+// addServer creates a server, it returns true if the server was created
+func (r *ServerReconciler) addServer(result *ServerReconcileResult, backend string, server models.Server) bool {
+	change := ServerChange{Backend: backend, Server: server.Name, Action: ServerChangeAdd}
+	attributes, err := runtimeAddServerAttributes(server)
+	if err == nil {
+		err = r.runtime.AddServer(backend, server.Name, attributes)
+	}
+	// dynamic servers are created in maintenance, with checks disabled
+	if err == nil && server.Check == "enabled" {
+		err = r.runtime.EnableServerHealth(backend, server.Name)
+	}
+	if err == nil && server.AgentCheck == "enabled" {
+		err = r.runtime.EnableAgentCheck(backend, server.Name)
+	}
+	if err == nil && server.Maintenance != "enabled" {
+		err = r.runtime.EnableServer(backend, server.Name)
+	}
+	if err != nil {
+		change.Err = err
+		result.ReloadRequired = append(result.ReloadRequired, change)
+		return false
+	}
+	result.Applied = append(result.Applied, change)
+	return true
+}
+
+// deleteServer deletes a server, it returns true if the server was deleted
+func (r *ServerReconciler) deleteServer(result *ServerReconcileResult, backend string, state *models.RuntimeServer) bool {
+	change := ServerChange{Backend: backend, Server: state.Name, Action: ServerChangeDelete}
+	// a server must be in maintenance before it can be deleted
+	err := r.runtime.DisableServer(backend, state.Name)
+	if err == nil {
+		if err = r.runtime.DeleteServer(backend, state.Name); err != nil && state.AdminState != models.RuntimeServerAdminStateMaint {
+			// the server is put back in service, it still runs until the reload
+			if enableErr := r.runtime.EnableServer(backend, state.Name); enableErr != nil {
+				err = fmt.Errorf("%w, server left in maintenance: %w", err, enableErr)
+			}
+		}
+	}
+	if err != nil {
+		change.Err = err
+		result.ReloadRequired = append(result.ReloadRequired, change)
+		return false
+	}
+	result.Applied = append(result.Applied, change)
+	return true
+}
+
+// updateServer applies the differences between a configured server and its runtime state. Fields not
+// visible in the runtime state are compared with the last server known to be running, all the fields
+// set being reported when there is none. It returns the server now known to be running.
+func (r *ServerReconciler) updateServer(result *ServerReconcileResult, backend string, server models.Server, state *models.RuntimeServer, last models.Server, known bool) models.Server { //nolint:gocognit,cyclop
+	apply := func(field, from, to string, fn func() error) bool {
+		change := ServerChange{Backend: backend, Server: server.Name, Action: ServerChangeUpdate, Field: field, From: from, To: to}
+		if fn == nil {
+			result.ReloadRequired = append(result.ReloadRequired, change)
+			return false
+		}
+		if err := fn(); err != nil {
+			change.Err = err
+			result.ReloadRequired = append(result.ReloadRequired, change)
+			return false
+		}
+		result.Applied = append(result.Applied, change)
+		return true
+	}
+
+	// address and port
+	if net.ParseIP(server.Address) != nil {
+		port := 0
+		if server.Port != nil {
+			port = int(*server.Port)
+		}
+		addrChanged := server.Address != state.Address
+		portChanged := server.Port != nil && (state.Port == nil || *state.Port != *server.Port)
+		if addrChanged || portChanged {
+			apply("address", joinAddress(state.Address, state.Port), joinAddress(server.Address, server.Port), func() error {
+				return r.runtime.SetServerAddr(backend, server.Name, server.Address, port)
+			})
+		}
+	} else if server.Address != state.Fqdn {
+		apply("fqdn", state.Fqdn, server.Address, nil)
+	}
+
+	// weight
+	if server.Weight != nil && (state.Weight == nil || *state.Weight != *server.Weight) {
+		apply("weight", int64PString(state.Weight), strconv.FormatInt(*server.Weight, 10), func() error {
+			return r.runtime.SetServerWeight(backend, server.Name, strconv.FormatInt(*server.Weight, 10))
+		})
+	}
+
+	// maintenance, a drained server is left as it is
+	switch {
+	case server.Maintenance == "enabled" && state.AdminState != models.RuntimeServerAdminStateMaint:
+		apply("state", state.AdminState, models.RuntimeServerAdminStateMaint, func() error {
+			return r.runtime.SetServerState(backend, server.Name, models.RuntimeServerAdminStateMaint)
+		})
+	case server.Maintenance != "enabled" && state.AdminState == models.RuntimeServerAdminStateMaint:
+		apply("state", state.AdminState, models.RuntimeServerAdminStateReady, func() error {
+			return r.runtime.SetServerState(backend, server.Name, models.RuntimeServerAdminStateReady)
+		})
+	}
+
+	// agent
+	if server.AgentCheck != "" && state.AgentState != nil {
+		enabled := *state.AgentState&agentCheckEnabled != 0
+		switch {
+		case server.AgentCheck == "enabled" && !enabled:
+			apply("agent-check", "disabled", "enabled", func() error {
+				return r.runtime.EnableAgentCheck(backend, server.Name)
+			})
+		case server.AgentCheck == "disabled" && enabled:
+			apply("agent-check", "enabled", "disabled", func() error {
+				return r.runtime.DisableAgentCheck(backend, server.Name)
+			})
+		}
+	}
+	if server.AgentAddr != "" && server.AgentAddr != state.AgentAddr {
+		apply("agent-addr", state.AgentAddr, server.AgentAddr, func() error {
+			return r.runtime.SetServerAgentAddr(backend, server.Name, server.AgentAddr)
+		})
+	}
+	if server.AgentPort != nil && (state.AgentPort == nil || *state.AgentPort != *server.AgentPort) {
+		apply("agent-port", int64PString(state.AgentPort), strconv.FormatInt(*server.AgentPort, 10), nil)
+	}
+
+	// ssl
+	if server.Ssl != "" && state.UseSsl != nil && *state.UseSsl != (server.Ssl == "enabled") {
+		to := "off"
+		if server.Ssl == "enabled" {
+			to = "on"
+		}
+		apply("ssl", strconv.FormatBool(*state.UseSsl), to, func() error {
+			return r.runtime.SetServerSSL(backend, server.Name, to)
+		})
+	}
+
+	// check port
+	if server.HealthCheckPort != nil && (state.CheckPort == nil || *state.CheckPort != *server.HealthCheckPort) {
+		apply("check-port", int64PString(state.CheckPort), strconv.FormatInt(*server.HealthCheckPort, 10), func() error {
+			return r.runtime.SetServerCheckPort(backend, server.Name, int(*server.HealthCheckPort))
+		})
+	}
+
+	// fields not visible in the runtime state are compared with the last server known to be running
+	if !known {
+		last = models.Server{Name: server.Name}
+	}
+	pending := false
+	for _, field := range sortedDiffFields(last.Diff(server)) {
+		switch field {
+		case "ServerParams.AgentSend":
+			if apply("agent-send", last.AgentSend, server.AgentSend, func() error {
+				return r.runtime.SetServerAgentSend(backend, server.Name, server.AgentSend)
+			}) {
+				last.AgentSend = server.AgentSend
+			} else {
+				pending = true
+			}
+		default:
+			if _, ok := runtimeServerFields[field]; ok {
+				continue
+			}
+			apply(strings.TrimPrefix(field, "ServerParams."), "", "", nil)
+			pending = true
+		}
+	}
+	if pending {
+		return last
+	}
+	return server
+}
+
+// runtimeAddServerAttributes returns the `add server` arguments for a configured server
+func runtimeAddServerAttributes(server models.Server) (string, error) {
+	if server.Address == "" {
+		return "", errors.New("server address is empty")
+	}
+	server.ServerParams = *server.PrepareFieldsForRuntimeAddServer()
+	serialized := configuration.SerializeServer(server, &options.ConfigurationOptions{PreferredTimeSuffix: options.DefaultTimeSuffix})
+	return strings.TrimSpace(serialized.Address + " " + params.ServerOptionsString(serialized.Params)), nil
+}
+
+// sortedDiffFields returns the top level fields of a Diff result
+func sortedDiffFields(diff map[string][]any) []string {
+	fields := make(map[string]struct{}, len(diff))
+	for key := range diff {
+		field, hasParams := strings.CutPrefix(key, "ServerParams.")
+		if i := strings.IndexAny(field, ".["); i > 0 {
+			field = field[:i]
+		}
+		if hasParams {
+			field = "ServerParams." + field
+		}
+		fields[field] = struct{}{}
+	}
+	result := make([]string, 0, len(fields))
+	for field := range fields {
+		result = append(result, field)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func sortedServerNames(servers map[string]models.Server) []string {
+	names := make([]string, 0, len(servers))
+	for name := range servers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isTemplateServer reports whether a runtime server was created by a server-template
+func isTemplateServer(name string, templates models.ServerTemplates) bool {
+	for _, t := range templates {
+		if t == nil || t.Prefix == "" {
+			continue
+		}
+		if suffix, ok := strings.CutPrefix(name, t.Prefix); ok {
+			if _, err := strconv.Atoi(suffix); err == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func joinAddress(address string, port *int64) string {
+	if port == nil {
+		return address
+	}
+	return net.JoinHostPort(address, strconv.FormatInt(*port, 10))
+}
+
+func int64PString(v *int64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatInt(*v, 10)
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package clientnative
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/configuration"
+	"github.com/haproxytech/client-native/v6/misc"
+	"github.com/haproxytech/client-native/v6/models"
+	"github.com/haproxytech/client-native/v6/runtime"
+)
+
+type reconcilerConfigurationMock struct {
+	configuration.Configuration
+
+	servers models.Servers
+}
+
+func (c *reconcilerConfigurationMock) GetServers(_, _, _ string) (int64, models.Servers, error) {
+	return 1, c.servers, nil
+}
+
+func (c *reconcilerConfigurationMock) GetBackends(_ string) (int64, models.Backends, error) {
+	return 1, models.Backends{&models.Backend{BackendBase: models.BackendBase{Name: "be"}}}, nil
+}
+
+func (c *reconcilerConfigurationMock) GetServerTemplates(_, _ string) (int64, models.ServerTemplates, error) {
+	return 1, models.ServerTemplates{&models.ServerTemplate{Prefix: "tpl", NumOrRange: "1-2"}}, nil
+}
+
+type reconcilerRuntimeMock struct {
+	runtime.Runtime
+
+	state     models.RuntimeServers
+	commands  []string
+	deleteErr error
+}
+
+func (r *reconcilerRuntimeMock) GetServersState(_ string) (models.RuntimeServers, error) {
+	return r.state, nil
+}
+
+func (r *reconcilerRuntimeMock) record(format string, a ...any) error {
+	r.commands = append(r.commands, fmt.Sprintf(format, a...))
+	return nil
+}
+
+func (r *reconcilerRuntimeMock) AddServer(backend, name, attributes string) error {
+	return r.record("add server %s/%s %s", backend, name, attributes)
+}
+
+func (r *reconcilerRuntimeMock) DeleteServer(backend, name string) error {
+	_ = r.record("del server %s/%s", backend, name)
+	return r.deleteErr
+}
+
+func (r *reconcilerRuntimeMock) DisableServer(backend, server string) error {
+	return r.record("disable server %s/%s", backend, server)
+}
+
+func (r *reconcilerRuntimeMock) EnableServer(backend, server string) error {
+	return r.record("enable server %s/%s", backend, server)
+}
+
+func (r *reconcilerRuntimeMock) EnableServerHealth(backend, server string) error {
+	return r.record("enable health %s/%s", backend, server)
+}
+
+func (r *reconcilerRuntimeMock) SetServerAddr(backend, server string, ip string, port int) error {
+	return r.record("set server %s/%s addr %s port %d", backend, server, ip, port)
+}
+
+func (r *reconcilerRuntimeMock) SetServerWeight(backend, server string, weight string) error {
+	return r.record("set server %s/%s weight %s", backend, server, weight)
+}
+
+func (r *reconcilerRuntimeMock) SetServerState(backend, server string, state string) error {
+	return r.record("set server %s/%s state %s", backend, server, state)
+}
+
+func TestServerReconciler_ReconcileBackend(t *testing.T) {
+	cfg := &reconcilerConfigurationMock{
+		servers: models.Servers{
+			&models.Server{Name: "s1", Address: "10.0.0.2", Port: misc.Int64P(8080), ServerParams: models.ServerParams{Weight: misc.Int64P(20)}},
+			&models.Server{Name: "s2", Address: "10.0.0.3", Port: misc.Int64P(80), ServerParams: models.ServerParams{Check: "enabled", Maintenance: "disabled"}},
+			&models.Server{Name: "s3", Address: "10.0.0.4", Port: misc.Int64P(80), ServerParams: models.ServerParams{Maintenance: "enabled"}},
+		},
+	}
+	rt := &reconcilerRuntimeMock{
+		state: models.RuntimeServers{
+			&models.RuntimeServer{Name: "s1", Address: "10.0.0.1", Port: misc.Int64P(8080), Weight: misc.Int64P(1), AdminState: "ready"},
+			&models.RuntimeServer{Name: "s3", Address: "10.0.0.4", Port: misc.Int64P(80), AdminState: "ready"},
+			&models.RuntimeServer{Name: "old", Address: "10.0.0.9", Port: misc.Int64P(80), AdminState: "ready"},
+			&models.RuntimeServer{Name: "tpl1", Address: "10.0.0.10", Port: misc.Int64P(80), AdminState: "ready"},
+		},
+	}
+
+	r := NewServerReconciler(cfg, rt)
+	result, err := r.ReconcileBackend("be")
+	if err != nil {
+		t.Fatalf("ReconcileBackend() error = %v", err)
+	}
+	want := []string{
+		"set server be/s1 addr 10.0.0.2 port 8080",
+		"set server be/s1 weight 20",
+		"add server be/s2 10.0.0.3:80 check",
+		"enable health be/s2",
+		"enable server be/s2",
+		"set server be/s3 state maint",
+		"disable server be/old",
+		"del server be/old",
+	}
+	if !reflect.DeepEqual(rt.commands, want) {
+		t.Errorf("ReconcileBackend() commands = %q, want %q", rt.commands, want)
+	}
+	if result.NeedsReload() {
+		t.Errorf("ReconcileBackend() unexpected reload required: %v", result.ReloadRequired)
+	}
+	if len(result.Applied) != 5 {
+		t.Errorf("ReconcileBackend() applied %d changes, want 5: %v", len(result.Applied), result.Applied)
+	}
+
+	// a parameter not exposed by the runtime API requires a reload
+	rt.commands = nil
+	rt.state = models.RuntimeServers{
+		&models.RuntimeServer{Name: "s1", Address: "10.0.0.2", Port: misc.Int64P(8080), Weight: misc.Int64P(20), AdminState: "ready"},
+		&models.RuntimeServer{Name: "s2", Address: "10.0.0.3", Port: misc.Int64P(80), AdminState: "ready"},
+		&models.RuntimeServer{Name: "s3", Address: "10.0.0.4", Port: misc.Int64P(80), AdminState: "maint"},
+	}
+	cfg.servers[0].Inter = misc.Int64P(5000)
+	result, err = r.ReconcileBackend("be")
+	if err != nil {
+		t.Fatalf("ReconcileBackend() error = %v", err)
+	}
+	if len(rt.commands) != 0 {
+		t.Errorf("ReconcileBackend() unexpected commands %q", rt.commands)
+	}
+	if len(result.ReloadRequired) != 1 || result.ReloadRequired[0].Field != "Inter" {
+		t.Errorf("ReconcileBackend() reload required = %v, want Inter change", result.ReloadRequired)
+	}
+
+	// the change is still pending until the reload
+	result, err = r.ReconcileBackend("be")
+	if err != nil {
+		t.Fatalf("ReconcileBackend() error = %v", err)
+	}
+	if len(result.ReloadRequired) != 1 || result.ReloadRequired[0].Field != "Inter" {
+		t.Errorf("ReconcileBackend() reload required = %v, want Inter change again", result.ReloadRequired)
+	}
+	if err = r.Reloaded(); err != nil {
+		t.Fatalf("Reloaded() error = %v", err)
+	}
+	result, err = r.ReconcileBackend("be")
+	if err != nil {
+		t.Fatalf("ReconcileBackend() error = %v", err)
+	}
+	if result.NeedsReload() {
+		t.Errorf("ReconcileBackend() unexpected reload required after reload: %v", result.ReloadRequired)
+	}
+}
+
+func TestServerReconciler_FirstRun(t *testing.T) {
+	cfg := &reconcilerConfigurationMock{
+		servers: models.Servers{
+			&models.Server{Name: "s1", Address: "10.0.0.1", Port: misc.Int64P(80), ServerParams: models.ServerParams{Inter: misc.Int64P(5000)}},
+		},
+	}
+	rt := &reconcilerRuntimeMock{
+		state: models.RuntimeServers{
+			&models.RuntimeServer{Name: "s1", Address: "10.0.0.1", Port: misc.Int64P(80), AdminState: "ready"},
+		},
+	}
+
+	// the committed configuration is running, nothing is pending
+	r := NewServerReconciler(cfg, rt)
+	result, err := r.ReconcileBackend("be")
+	if err != nil {
+		t.Fatalf("ReconcileBackend() error = %v", err)
+	}
+	if result.NeedsReload() || len(result.Applied) != 0 {
+		t.Errorf("ReconcileBackend() changes = %v %v, want none", result.Applied, result.ReloadRequired)
+	}
+
+	cfg.servers[0].Inter = misc.Int64P(3000)
+	result, err = r.ReconcileBackend("be")
+	if err != nil {
+		t.Fatalf("ReconcileBackend() error = %v", err)
+	}
+	if len(result.ReloadRequired) != 1 || result.ReloadRequired[0].Field != "Inter" {
+		t.Errorf("ReconcileBackend() reload required = %v, want Inter change", result.ReloadRequired)
+	}
+}
+
+func TestServerReconciler_DeleteFailure(t *testing.T) {
+	cfg := &reconcilerConfigurationMock{}
+	rt := &reconcilerRuntimeMock{
+		state: models.RuntimeServers{
+			&models.RuntimeServer{Name: "old", Address: "10.0.0.9", Port: misc.Int64P(80), AdminState: "ready"},
+		},
+		deleteErr: errors.New("server has active connections"),
+	}
+
+	result, err := NewServerReconciler(cfg, rt).ReconcileBackend("be")
+	if err != nil {
+		t.Fatalf("ReconcileBackend() error = %v", err)
+	}
+	want := []string{
+		"disable server be/old",
+		"del server be/old",
+		"enable server be/old",
+	}
+	if !reflect.DeepEqual(rt.commands, want) {
+		t.Errorf("ReconcileBackend() commands = %q, want %q", rt.commands, want)
+	}
+	if len(result.ReloadRequired) != 1 || result.ReloadRequired[0].Action != ServerChangeDelete {
+		t.Errorf("ReconcileBackend() reload required = %v, want the deletion", result.ReloadRequired)
+	}
+}
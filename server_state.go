@@ -0,0 +1,114 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package clientnative
+
+import (
+	"path/filepath"
+
+	"github.com/google/renameio"
+	"github.com/haproxytech/client-native/v6/configuration"
+	"github.com/haproxytech/client-native/v6/models"
+	"github.com/haproxytech/client-native/v6/runtime"
+)
+
+// serverStateFileUseBackendName is the server-state-file-name value using the backend name
+const serverStateFileUseBackendName = "use-backend-name"
+
+// ServerStateFile is a file HAProxy loads the state of servers from on startup
+type ServerStateFile struct {
+	Path string
+	// Backend is the backend loading its servers state from a local file,
+	// empty for the global server-state-file
+	Backend string
+}
+
+// GetServerStateFiles returns the server state files of the configuration: the global
+// server-state-file, and a file for each backend with load-server-state-from-file local
+func GetServerStateFiles(cfg configuration.Configuration) ([]ServerStateFile, error) {
+	_, global, err := cfg.GetGlobalConfiguration("")
+	if err != nil {
+		return nil, err
+	}
+	var base, file string
+	if global.PerformanceOptions != nil {
+		base = global.PerformanceOptions.ServerStateBase
+		file = global.PerformanceOptions.ServerStateFile
+	}
+
+	var files []ServerStateFile
+	if file != "" {
+		files = append(files, ServerStateFile{Path: serverStatePath(base, file)})
+	}
+
+	_, backends, err := cfg.GetBackends("")
+	if err != nil {
+		return nil, err
+	}
+	for _, be := range backends {
+		mode := be.LoadServerStateFromFile
+		if mode == "" {
+			var defaults *models.Defaults
+			if be.From != "" {
+				_, defaults, _ = cfg.GetDefaultsSection(be.From, "")
+			} else {
+				_, defaults, _ = cfg.GetDefaultsConfiguration("")
+			}
+			if defaults != nil {
+				mode = defaults.LoadServerStateFromFile
+			}
+		}
+		if mode != models.BackendBaseLoadServerStateFromFileLocal {
+			continue
+		}
+		name := be.ServerStateFileName
+		if name == "" || name == serverStateFileUseBackendName {
+			name = be.Name
+		}
+		files = append(files, ServerStateFile{Path: serverStatePath(base, name), Backend: be.Name})
+	}
+	return files, nil
+}
+
+// DumpServerState writes the state of the servers of the running HAProxy to the server
+// state files of the configuration, so it is kept across a reload. Files are replaced
+// atomically and the written paths are returned.
+func DumpServerState(cfg configuration.Configuration, rt runtime.Runtime) ([]string, error) {
+	files, err := GetServerStateFiles(cfg)
+	if err != nil {
+		return nil, err
+	}
+	written := make([]string, 0, len(files))
+	for _, f := range files {
+		state, err := rt.DumpServersState(f.Backend)
+		if err != nil {
+			return written, err
+		}
+		if err = renameio.WriteFile(f.Path, []byte(state), 0o644); err != nil {
+			return written, err
+		}
+		written = append(written, f.Path)
+	}
+	return written, nil
+}
+
+// serverStatePath returns the path of a server state file, relative file names being
+// relative to server-state-base
+func serverStatePath(base, file string) string {
+	if base == "" || filepath.IsAbs(file) {
+		return file
+	}
+	return filepath.Join(base, file)
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package clientnative
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/haproxytech/client-native/v6/configuration"
+	"github.com/haproxytech/client-native/v6/models"
+	"github.com/haproxytech/client-native/v6/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+type serverStateConfigurationMock struct {
+	configuration.Configuration
+
+	global   *models.Global
+	defaults *models.Defaults
+	backends models.Backends
+}
+
+func (c *serverStateConfigurationMock) GetGlobalConfiguration(_ string) (int64, *models.Global, error) {
+	return 1, c.global, nil
+}
+
+func (c *serverStateConfigurationMock) GetBackends(_ string) (int64, models.Backends, error) {
+	return 1, c.backends, nil
+}
+
+func (c *serverStateConfigurationMock) GetDefaultsConfiguration(_ string) (int64, *models.Defaults, error) {
+	return 1, c.defaults, nil
+}
+
+type serverStateRuntimeMock struct {
+	runtime.Runtime
+}
+
+func (r *serverStateRuntimeMock) DumpServersState(backend string) (string, error) {
+	return "1\n# be_id be_name\n" + backend + "\n", nil
+}
+
+func TestDumpServerState(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &serverStateConfigurationMock{
+		global: &models.Global{GlobalBase: models.GlobalBase{PerformanceOptions: &models.PerformanceOptions{
+			ServerStateBase: dir,
+			ServerStateFile: "global.state",
+		}}},
+		defaults: &models.Defaults{DefaultsBase: models.DefaultsBase{
+			LoadServerStateFromFile: models.BackendBaseLoadServerStateFromFileGlobal,
+		}},
+		backends: models.Backends{
+			&models.Backend{BackendBase: models.BackendBase{Name: "be_global"}},
+			&models.Backend{BackendBase: models.BackendBase{Name: "be_local", LoadServerStateFromFile: models.BackendBaseLoadServerStateFromFileLocal}},
+			&models.Backend{BackendBase: models.BackendBase{
+				Name: "be_named", LoadServerStateFromFile: models.BackendBaseLoadServerStateFromFileLocal, ServerStateFileName: "/var/state/named",
+			}},
+		},
+	}
+
+	files, err := GetServerStateFiles(cfg)
+	require.NoError(t, err)
+	require.Equal(t, []ServerStateFile{
+		{Path: filepath.Join(dir, "global.state")},
+		{Path: filepath.Join(dir, "be_local"), Backend: "be_local"},
+		{Path: "/var/state/named", Backend: "be_named"},
+	}, files)
+
+	cfg.backends = cfg.backends[:2]
+	written, err := DumpServerState(cfg, &serverStateRuntimeMock{})
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "global.state"), filepath.Join(dir, "be_local")}, written)
+	data, err := os.ReadFile(filepath.Join(dir, "be_local"))
+	require.NoError(t, err)
+	require.Equal(t, "1\n# be_id be_name\nbe_local\n", string(data))
+}
@@ -287,6 +287,17 @@ func (c *SingleSpoe) Save(transactionFile, transactionID string) error {
 	return p.Save(transactionFile)
 }
 
+func (c *SingleSpoe) Render(transactionID string) (string, error) {
+	if transactionID == "" {
+		return c.Parser.String(), nil
+	}
+	p, err := c.GetParser(transactionID)
+	if err != nil {
+		return "", err
+	}
+	return p.String(), nil
+}
+
 func (c *SingleSpoe) GetFailedParserTransactionVersion(transactionID string) (int64, error) {
 	p := &spoe.Parser{}
 	if err := p.LoadData(transactionID); err != nil {
@@ -16,6 +16,9 @@
 package storage
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
 	"crypto/sha1" //nolint:gosec
 	"crypto/sha256"
 	"crypto/x509"
@@ -34,6 +37,7 @@ type CertificatesInfo struct {
 	SubjectKeyID            string
 	Serial                  string
 	Algorithm               string
+	KeyType                 string
 	Sha1FingerPrint         string
 	Sha256FingerPrint       string
 	Subject                 string
@@ -48,6 +52,7 @@ type certsInfo struct {
 	SubjectKeyID            string
 	Serial                  string
 	Algorithm               string
+	KeyType                 string
 	Sha1FingerPrint         string
 	Sha256FingerPrint       string
 	Subject                 string
@@ -87,6 +92,7 @@ func ParseCertificatesInfo(bundle []byte) (*CertificatesInfo, error) {
 		ci.SubjectKeyID = formatFingerprint(crt.SubjectKeyId)
 		ci.Serial = crt.SerialNumber.String()
 		ci.Algorithm = crt.SignatureAlgorithm.String()
+		ci.KeyType = keyType(crt)
 		// Format the fingerprint as OpenSSL does: hex digits in uppercase, colon-separated
 		fingerPrint := sha1.Sum(crt.Raw) //nolint:gosec
 		ci.Sha1FingerPrint = formatFingerprint(fingerPrint[:])
@@ -136,6 +142,19 @@ func (ci *certsInfo) parseCertificate(der []byte) error {
 	return nil
 }
 
+// keyType returns the public key type and size as HAProxy reports it, e.g. RSA2048 or EC256.
+func keyType(crt *x509.Certificate) string {
+	switch key := crt.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA%d", key.N.BitLen())
+	case *ecdsa.PublicKey:
+		return fmt.Sprintf("EC%d", key.Curve.Params().BitSize)
+	case ed25519.PublicKey:
+		return "ED25519"
+	}
+	return crt.PublicKeyAlgorithm.String()
+}
+
 // formatFingerprint formats a byte array as: hex digits in uppercase, colon-separated
 func formatFingerprint(fingerprint []byte) string {
 	parts := make([]string, len(fingerprint))
@@ -180,6 +199,7 @@ func (ci *certsInfo) toCertificatesInfo() *CertificatesInfo {
 		SubjectKeyID:            ci.SubjectKeyID,
 		Serial:                  ci.Serial,
 		Algorithm:               ci.Algorithm,
+		KeyType:                 ci.KeyType,
 		Sha1FingerPrint:         ci.Sha1FingerPrint,
 		Sha256FingerPrint:       ci.Sha256FingerPrint,
 		Subject:                 ci.Subject,
@@ -0,0 +1,141 @@
+// Copyright 2026 HAProxy Technologies
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package storage
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// ValidateCertificateBundle checks that a PEM bundle can be loaded by HAProxy as is:
+// it must hold exactly one private key matching the leaf certificate, and every
+// certificate of the bundle must be part of the chain starting from the leaf.
+// When roots is not nil, the chain is also verified against those trusted roots,
+// otherwise a leaf certificate which is not self-signed must come with its issuer.
+func ValidateCertificateBundle(raw []byte, roots *x509.CertPool) error {
+	var certs []*x509.Certificate
+	var keys []crypto.PrivateKey
+
+	for {
+		block, rest := pem.Decode(raw)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			crt, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return fmt.Errorf("invalid certificate: %w", err)
+			}
+			certs = append(certs, crt)
+		} else if key, err := parsePrivateKey(block.Bytes); err == nil {
+			keys = append(keys, key)
+		}
+		raw = rest
+	}
+
+	switch {
+	case len(certs) == 0:
+		return errors.New("no certificate found")
+	case len(keys) == 0:
+		return errors.New("no private key found")
+	case len(keys) > 1:
+		return errors.New("more than one private key found")
+	}
+
+	leaf, err := findLeafCertificate(certs)
+	if err != nil {
+		return err
+	}
+
+	if err = checkKeyMatchesCertificate(keys[0], leaf); err != nil {
+		return err
+	}
+
+	chain := buildChain(leaf, certs)
+	if len(chain) != len(certs) {
+		last := chain[len(chain)-1]
+		return fmt.Errorf("incomplete certificate chain: issuer '%s' of '%s' is missing", last.Issuer.String(), last.Subject.String())
+	}
+
+	if roots == nil && len(chain) == 1 && !isSelfSigned(leaf) {
+		return fmt.Errorf("incomplete certificate chain: issuer '%s' of '%s' is missing", leaf.Issuer.String(), leaf.Subject.String())
+	}
+
+	if roots != nil {
+		intermediates := x509.NewCertPool()
+		for _, crt := range chain[1:] {
+			intermediates.AddCert(crt)
+		}
+		_, err = leaf.Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		})
+		if err != nil {
+			return fmt.Errorf("certificate chain verification failed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// buildChain follows the issuers of the leaf certificate within certs.
+// Only signatures are checked here, CA constraints are left to the verification
+// against trusted roots.
+func buildChain(leaf *x509.Certificate, certs []*x509.Certificate) []*x509.Certificate {
+	chain := []*x509.Certificate{leaf}
+	current := leaf
+	for len(chain) < len(certs) {
+		if bytes.Equal(current.RawIssuer, current.RawSubject) {
+			break
+		}
+		var issuer *x509.Certificate
+		for _, crt := range certs {
+			if crt != current && bytes.Equal(crt.RawSubject, current.RawIssuer) &&
+				crt.CheckSignature(current.SignatureAlgorithm, current.RawTBSCertificate, current.Signature) == nil {
+				issuer = crt
+				break
+			}
+		}
+		if issuer == nil {
+			break
+		}
+		chain = append(chain, issuer)
+		current = issuer
+	}
+	return chain
+}
+
+func isSelfSigned(crt *x509.Certificate) bool {
+	return bytes.Equal(crt.RawIssuer, crt.RawSubject) &&
+		crt.CheckSignature(crt.SignatureAlgorithm, crt.RawTBSCertificate, crt.Signature) == nil
+}
+
+func checkKeyMatchesCertificate(key crypto.PrivateKey, crt *x509.Certificate) error {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return errors.New("unsupported private key type")
+	}
+	pub, ok := signer.Public().(interface{ Equal(x crypto.PublicKey) bool })
+	if !ok || !pub.Equal(crt.PublicKey) {
+		return fmt.Errorf("private key does not match certificate '%s'", crt.Subject.String())
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ package storage
 
 import (
 	"bytes"
+	"encoding/pem"
 	"io"
 	"path/filepath"
 	"reflect"
@@ -528,6 +529,78 @@ func Test_storage_getCertificatesInfo(t *testing.T) {
 				require.NotEmpty(t, info.Sha256FingerPrint)
 				require.NotEmpty(t, info.Subject)
 				require.NotEmpty(t, info.Serial)
+				require.NotEmpty(t, info.KeyType)
+			}
+		})
+	}
+}
+
+func TestValidateCertificateBundle(t *testing.T) {
+	chain, err := readPem("valid/OK-crt_key_int1_int2.pem")
+	require.NoError(t, err)
+	selfSigned, err := readPem("valid/selfsigned1.pem")
+	require.NoError(t, err)
+
+	// certificates of the valid chain with the key of the self-signed certificate
+	var mismatch []byte
+	for rest := chain; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			mismatch = append(mismatch, pem.EncodeToMemory(block)...)
+		}
+	}
+	for rest := selfSigned; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			mismatch = append(mismatch, pem.EncodeToMemory(block)...)
+		}
+	}
+
+	// leaf certificate and key of the valid chain, without the intermediates
+	var leafOnly []byte
+	for rest, leaf := chain, true; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" || leaf {
+			leafOnly = append(leafOnly, pem.EncodeToMemory(block)...)
+			leaf = leaf && block.Type != "CERTIFICATE"
+		}
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		content []byte
+		wantErr bool
+	}{
+		{name: "Should pass with a complete chain", file: "valid/OK-crt_key_int1_int2.pem"},
+		{name: "Should pass with a complete chain in any order", file: "valid/OK-int1_key_crt_int2.pem"},
+		{name: "Should pass with a self-signed certificate", file: "valid/selfsigned1.pem"},
+		{name: "Should fail with a missing intermediate", file: "invalid/NOK-crt_key_int1.pem", wantErr: true},
+		{name: "Should fail without private key", file: "invalid/NOK-int1_int2.pem", wantErr: true},
+		{name: "Should fail when the key does not match", content: mismatch, wantErr: true},
+		{name: "Should fail with only the leaf certificate", content: leafOnly, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := tt.content
+			if tt.file != "" {
+				content, err = readPem(tt.file)
+				require.NoError(t, err)
+			}
+			if err := ValidateCertificateBundle(content, nil); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCertificateBundle() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}